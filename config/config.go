@@ -27,6 +27,18 @@ const (
 	SunriseType   uint8  = 0
 	LewisburgType uint8  = 1
 	ApolloType    uint8  = 2
+	CannonType    uint8  = 3
+	TigerType     uint8  = 4
+	AlderType     uint8  = 5
+	MeteorType    uint8  = 6
+	GeminiType    uint8  = 7
+	DenvertonType uint8  = 8
+	EmmitsType    uint8  = 9
+	ElkhartType   uint8  = 10
+	JasperType    uint8  = 11
+	CougarType    uint8  = 12
+	BaytrailType  uint8  = 13
+	BraswellType  uint8  = 14
 )
 
 var key uint8 = SunriseType
@@ -34,7 +46,19 @@ var key uint8 = SunriseType
 var platform = map[string]uint8{
 	"snr": SunriseType,
 	"lbg": LewisburgType,
-	"apl": ApolloType}
+	"apl": ApolloType,
+	"cnl": CannonType,
+	"tgl": TigerType,
+	"adl": AlderType,
+	"mtl": MeteorType,
+	"glk": GeminiType,
+	"dnv": DenvertonType,
+	"ebg": EmmitsType,
+	"ehl": ElkhartType,
+	"jsl": JasperType,
+	"cpt": CougarType,
+	"byt": BaytrailType,
+	"bsw": BraswellType}
 func PlatformSet(name string) int {
 	if platformType, valid := platform[name]; valid {
 		key = platformType
@@ -57,6 +81,91 @@ func IsPlatformSunrise() bool {
 func IsPlatformLewisburg() bool {
 	return IsPlatform(LewisburgType)
 }
+func IsPlatformCannon() bool {
+	return IsPlatform(CannonType)
+}
+func IsPlatformTiger() bool {
+	return IsPlatform(TigerType)
+}
+func IsPlatformAlder() bool {
+	return IsPlatform(AlderType)
+}
+func IsPlatformMeteor() bool {
+	return IsPlatform(MeteorType)
+}
+func IsPlatformGemini() bool {
+	return IsPlatform(GeminiType)
+}
+func IsPlatformDenverton() bool {
+	return IsPlatform(DenvertonType)
+}
+func IsPlatformEmmitsburg() bool {
+	return IsPlatform(EmmitsType)
+}
+func IsPlatformElkhart() bool {
+	return IsPlatform(ElkhartType)
+}
+func IsPlatformJasper() bool {
+	return IsPlatform(JasperType)
+}
+func IsPlatformCougar() bool {
+	return IsPlatform(CougarType)
+}
+func IsPlatformBaytrail() bool {
+	return IsPlatform(BaytrailType)
+}
+func IsPlatformBraswell() bool {
+	return IsPlatform(BraswellType)
+}
+
+// The platforms without the pad configuration registers use the GPIO tables
+// of their own backends instead of the pad_config table
+func IsPlatformLegacy() bool {
+	return IsPlatformCougar() || IsPlatformBaytrail() || IsPlatformBraswell()
+}
+
+// The first SKU of the platform is used by default, except for Sunrise that
+// detects the SKU from the pad groups of the dump if it is not set
+var sku string = ""
+var skus = map[uint8][]string{
+	SunriseType: {"lp", "h", "kbp"},
+	CannonType:  {"lp", "h"},
+	TigerType:   {"lp", "h"},
+	AlderType:   {"p", "s", "n"}}
+func SkuSet(name string) int {
+	for _, valid := range skus[key] {
+		if name == valid {
+			sku = name
+			return 0
+		}
+	}
+	return -1
+}
+func SkuGet() string {
+	if sku == "" && skuDetected != "" {
+		return skuDetected
+	}
+	if sku == "" && len(skus[key]) != 0 {
+		return skus[key][0]
+	}
+	return sku
+}
+func IsSku(name string) bool {
+	return SkuGet() == name
+}
+func IsSkuSet() bool {
+	return sku != ""
+}
+
+// The SKU detected from the dump, it is used instead of the default SKU if the
+// SKU is not set
+var skuDetected string = ""
+func SkuDetectedSet(name string) {
+	skuDetected = name
+}
+func IsSkuKnown() bool {
+	return sku != "" || skuDetected != ""
+}
 
 var InputRegDumpFile *os.File = nil
 var OutputGenFile *os.File = nil
@@ -119,3 +228,59 @@ func IsFspStyleMacro() bool {
 func IsRawFields() bool {
 	return FldStyleGet() == RawFlds
 }
+
+var layout uint8 = GpioHLayout
+const (
+	GpioHLayout           uint8  = 0
+	CorebootVariantLayout uint8  = 1
+)
+var layoutmap = map[string]uint8{
+	"gpio.h"           : GpioHLayout,
+	"coreboot-variant" : CorebootVariantLayout}
+func LayoutSet(name string) int {
+	if style, valid := layoutmap[name]; valid {
+		layout = style
+		return 0
+	}
+	return -1
+}
+func LayoutGet() uint8 {
+	return layout
+}
+func IsVariantLayoutUsed() bool {
+	return LayoutGet() == CorebootVariantLayout
+}
+
+var corebootVersion string = ""
+var corebootVersions = []string{"4.7", "4.11", "4.12"}
+func CorebootVersionSet(version string) int {
+	for _, valid := range corebootVersions {
+		if version == valid {
+			corebootVersion = version
+			return 0
+		}
+	}
+	return -1
+}
+func CorebootVersionGet() string {
+	return corebootVersion
+}
+func CorebootVersionsGet() []string {
+	return corebootVersions
+}
+
+var assertFlag bool = false
+func AssertFlagSet(flag bool) {
+	assertFlag = flag
+}
+func IsAssertFlagUsed() bool {
+	return assertFlag
+}
+
+var pinNumbersFlag bool = false
+func PinNumbersFlagSet(flag bool) {
+	pinNumbersFlag = flag
+}
+func IsPinNumbersFlagUsed() bool {
+	return pinNumbersFlag
+}
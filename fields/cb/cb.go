@@ -1,6 +1,7 @@
 package cb
 
-import "../../config"
+import "fmt"
+
 import "../../platforms/common"
 
 type FieldMacros struct {}
@@ -43,14 +44,14 @@ func generate(fileds ...*field) {
 	if allhidden { macro.Add("0") }
 }
 
-// DecodeDW0 - decode value of DW0 register
-func (FieldMacros) DecodeDW0() {
+// decodeDW0 - decode value of DW0 register
+func (FieldMacros) decodeDW0() {
 	macro := common.GetMacro()
 	dw0 := macro.Register(common.PAD_CFG_DW0)
 	generate(
 		&field {
 			prefix : "PAD_FUNC",
-			unhide : config.InfoLevelGet() <= 3 || dw0.GetPadMode() != 0,
+			unhide : macro.InfoLevelGet() <= 3 || dw0.GetPadMode() != 0,
 			configurator : func() { macro.Padfn() },
 		},
 
@@ -124,8 +125,8 @@ func (FieldMacros) DecodeDW0() {
 	)
 }
 
-// DecodeDW1 - decode value of DW1 register
-func (FieldMacros) DecodeDW1() {
+// decodeDW1 - decode value of DW1 register
+func (FieldMacros) decodeDW1() {
 	macro := common.GetMacro()
 	dw1 := macro.Register(common.PAD_CFG_DW1)
 	generate(
@@ -160,12 +161,57 @@ func (FieldMacros) DecodeDW1() {
 	)
 }
 
+// decodeDW2 - decode value of DW2 register
+func (FieldMacros) decodeDW2() {
+	macro := common.GetMacro()
+	dw2 := macro.Register(common.PAD_CFG_DW2)
+	generate(
+		&field {
+			name   : "PAD_CFG2_DEBEN",
+			unhide : dw2.GetDebounceEnable() != 0,
+		},
+
+		&field {
+			prefix : "",
+			unhide : dw2.GetDebounce() != 0,
+			configurator : func() { macro.Add("PAD_CFG2_").Debounce() },
+		},
+	)
+}
+
+// DecodeDW - decode value of the configuration register
+// number : register number
+func (bitfields FieldMacros) DecodeDW(number uint8) {
+	switch number {
+	case common.PAD_CFG_DW0:
+		bitfields.decodeDW0()
+	case common.PAD_CFG_DW1:
+		bitfields.decodeDW1()
+	case common.PAD_CFG_DW2:
+		bitfields.decodeDW2()
+	default:
+		macro := common.GetMacro()
+		macro.Add(fmt.Sprintf("0x%0.8x", macro.Register(number).ValueGet()))
+	}
+}
+
 // GenerateString - generates the entire string of bitfield macros.
 func (bitfields FieldMacros) GenerateString() {
 	macro := common.GetMacro()
-	macro.Add("_PAD_CFG_STRUCT(").Id().Add(", ")
-	bitfields.DecodeDW0()
-	macro.Add(", ")
-	bitfields.DecodeDW1()
+	dwnum := macro.DwNumGet()
+	if dwnum > common.PAD_CFG_DW2 && macro.Register(common.PAD_CFG_DW2).ValueGet() == 0 {
+		// DW2 has the default value, the short form is enough
+		dwnum = common.PAD_CFG_DW2
+	}
+	macro.Add("_PAD_CFG_STRUCT")
+	if dwnum > common.PAD_CFG_DW2 {
+		// _PAD_CFG_STRUCT_3(pad, config0, config1, config2)
+		macro.Add("_3")
+	}
+	macro.Add("(").Id()
+	for number := uint8(0); number < dwnum && number <= common.PAD_CFG_DW2; number++ {
+		macro.Add(", ")
+		bitfields.DecodeDW(number)
+	}
 	macro.Add("),")
 }
@@ -1,6 +1,7 @@
 package fsp
 
 import "../../platforms/common"
+import "../../config"
 
 type FieldMacros struct {}
 
@@ -34,8 +35,8 @@ func generate(fileds ...*field) {
 	}
 }
 
-// DecodeDW0 - decode value of DW0 register
-func (FieldMacros) DecodeDW0() {
+// decodeDW0 - decode value of DW0 register
+func (FieldMacros) decodeDW0() {
 	macro := common.GetMacro()
 	dw0 := macro.Register(common.PAD_CFG_DW0)
 
@@ -131,8 +132,8 @@ func (FieldMacros) DecodeDW0() {
 	)
 }
 
-// DecodeDW1 - decode value of DW1 register
-func (FieldMacros) DecodeDW1() {
+// decodeDW1 - decode value of DW1 register
+func (FieldMacros) decodeDW1() {
 	macro := common.GetMacro()
 	dw1 := macro.Register(common.PAD_CFG_DW1)
 	generate(
@@ -161,11 +162,38 @@ func (FieldMacros) DecodeDW1() {
 	)
 }
 
+// DecodeDW - decode value of the configuration register
+// number : register number, FSP GPIO_CONFIG only contains the fields of DW0 and DW1
+func (bitfields FieldMacros) DecodeDW(number uint8) {
+	switch number {
+	case common.PAD_CFG_DW0:
+		bitfields.decodeDW0()
+	case common.PAD_CFG_DW1:
+		bitfields.decodeDW1()
+	}
+}
+
+// padPrefixGet - returns the prefix of the pad name constants in FSP, the pad
+// names of PCH-H are used if the SKU is neither set nor detected from the dump
+func padPrefixGet() string {
+	if config.IsPlatformSunrise() && config.IsSkuKnown() && config.IsSku("lp") {
+		return "GPIO_SKL_LP_"
+	}
+	return "GPIO_SKL_H_"
+}
+
 // GenerateString - generates the entire string of bitfield macros.
 func (bitfields FieldMacros) GenerateString() {
 	macro := common.GetMacro()
-	macro.Add("{ GPIO_SKL_H_").Id().Add(", { ")
-	bitfields.DecodeDW0()
-	bitfields.DecodeDW1()
-	macro.Add(" GpioPadConfigLock },") // TODO: configure GpioPadConfigLock
+	macro.Add("{ ").Add(padPrefixGet()).Id().Add(", { ")
+	bitfields.DecodeDW(common.PAD_CFG_DW0)
+	bitfields.DecodeDW(common.PAD_CFG_DW1)
+	var lock = map[uint8]string{
+		common.PAD_UNLOCK:       "GpioPadConfigUnlock",
+		common.PAD_LOCK_CONFIG:  "GpioPadConfigLock",
+		common.PAD_LOCK_TX:      "GpioPadConfigUnlock | GpioOutputStateLock",
+		common.PAD_LOCK_FULL:    "GpioPadConfigLock | GpioOutputStateLock",
+		common.PAD_LOCK_UNKNOWN: "GpioLockDefault",
+	}
+	macro.Add(" ").Add(lock[macro.PadLockGet()]).Add(" },")
 }
@@ -5,24 +5,29 @@ import "../../platforms/common"
 
 type FieldMacros struct {}
 
-func (FieldMacros) DecodeDW0() {
+func (FieldMacros) DecodeDW(number uint8) {
 	macro := common.GetMacro()
 	// Do not decode, print as is.
-	macro.Add(fmt.Sprintf("0x%0.8x", macro.Register(common.PAD_CFG_DW0).ValueGet()))
-}
-
-func (FieldMacros) DecodeDW1() {
-	macro := common.GetMacro()
-	// Do not decode, print as is.	
-	macro.Add(fmt.Sprintf("0x%0.8x", macro.Register(common.PAD_CFG_DW1).ValueGet()))
+	macro.Add(fmt.Sprintf("0x%0.8x", macro.Register(number).ValueGet()))
 }
 
 // GenerateString - generates the entire string of bitfield macros.
 func (bitfields FieldMacros) GenerateString() {
 	macro := common.GetMacro()
-	macro.Add("_PAD_CFG_STRUCT(").Id().Add(", ")
-	bitfields.DecodeDW0()
-	macro.Add(", ")
-	bitfields.DecodeDW1()
+	dwnum := macro.DwNumGet()
+	if dwnum > common.PAD_CFG_DW2 && macro.Register(common.PAD_CFG_DW2).ValueGet() == 0 {
+		// DW2 has the default value, the short form is enough
+		dwnum = common.PAD_CFG_DW2
+	}
+	macro.Add("_PAD_CFG_STRUCT")
+	if dwnum > common.PAD_CFG_DW2 {
+		// _PAD_CFG_STRUCT_3(pad, config0, config1, config2)
+		macro.Add("_3")
+	}
+	macro.Add("(").Id()
+	for number := uint8(0); number < dwnum && number <= common.PAD_CFG_DW2; number++ {
+		macro.Add(", ")
+		bitfields.DecodeDW(number)
+	}
 	macro.Add("),")
 }
@@ -3,6 +3,7 @@ package main
 import "flag"
 import "fmt"
 import "os"
+import "strings"
 
 import "./parser"
 import "./config"
@@ -10,12 +11,18 @@ import "./config"
 // generateOutputFile - generates include file
 // parser            : parser data structure
 func generateOutputFile(parser *parser.ParserData) (err error) {
+	if config.IsPlatformLegacy() {
+		// gpio.c with struct pch_gpio_map or soc_gpio_map
+		parser.LegacyMapFprint()
+		return nil
+	}
 
 	config.OutputGenFile.WriteString(`/* SPDX-License-Identifier: GPL-2.0-only */
 
 #ifndef CFG_GPIO_H
 #define CFG_GPIO_H
 
+#include <commonlib/helpers.h>
 #include <gpio.h>
 
 /* Pad configuration was generated automatically using intelp2m utility */
@@ -23,8 +30,24 @@ static const struct pad_config gpio_table[] = {
 `)
 	// Add the pads map
 	parser.PadMapFprint()
-	config.OutputGenFile.WriteString(`};
-
+	config.OutputGenFile.WriteString("};\n")
+	if parser.LockMapFprint() {
+		config.OutputGenFile.WriteString(`
+/* Call from the SMM finalize handler of the mainboard */
+static inline void mainboard_lock_pads(void)
+{
+	gpio_lock_pads(pad_lock_config, ARRAY_SIZE(pad_lock_config));
+}
+`)
+	}
+	if config.IsAssertFlagUsed() {
+		parser.AssertMapFprint()
+	}
+	parser.GpeFprint()
+	parser.PseFprint()
+	parser.InterruptFprint()
+	parser.IrqMapFprint()
+	config.OutputGenFile.WriteString(`
 #endif /* CFG_GPIO_H */
 `)
 	return nil
@@ -81,17 +104,84 @@ func main() {
 	platform :=  flag.String("p", "snr", "set platform:\n"+
 		"\tsnr - Sunrise PCH or Skylake/Kaby Lake SoC\n"+
 		"\tlbg - Lewisburg PCH with Xeon SP\n"+
-		"\tapl - Apollo Lake SoC\n")
+		"\tebg - Emmitsburg PCH with Sapphire Rapids Xeon SP\n"+
+		"\tdnv - Denverton SoC\n"+
+		"\tapl - Apollo Lake SoC\n"+
+		"\tglk - Gemini Lake SoC\n"+
+		"\tcnl - Cannon Point PCH or Coffee Lake/Whiskey Lake SoC\n"+
+		"\tehl - Elkhart Lake SoC\n"+
+		"\tjsl - Jasper Lake SoC\n"+
+		"\ttgl - Tiger Lake SoC\n"+
+		"\tadl - Alder Lake SoC\n"+
+		"\tmtl - Meteor Lake SoC with the IOE die\n"+
+		"\tcpt - 6/7-series PCH (Cougar Point, Panther Point) or 8/9-series PCH\n"+
+		"\t      (Lynx Point, Wildcat Point) except the LP PCHs with LP_GPIO\n"+
+		"\t      registers, generates gpio.c with struct pch_gpio_map\n"+
+		"\tbyt - Bay Trail SoC, generates gpio.c with struct soc_gpio_map\n"+
+		"\tbsw - Braswell SoC, generates gpio.c with struct soc_gpio_map\n")
+
+	sku := flag.String("sku", "", "set PCH or SoC SKU of the platform:\n"+
+		"\tsnr - lp, h, kbp (Union Point), detected from the dump by default\n"+
+		"\tcnl - lp, h, detected from the dump by default\n"+
+		"\ttgl - lp (UP3/UP4, default), h\n"+
+		"\tadl - p (default), s, n\n")
 
 	filedstyle :=  flag.String("fld", "none", "set fileds macros style:\n"+
 		"\tcb  - use coreboot style for bit fields macros\n"+
 		"\tfsp - use fsp style\n"+
 		"\traw - do not convert, print as is\n")
 
+	assertFlag := flag.Bool("assert",
+		false,
+		"add _Static_assert() checks that the macros expand to the DW0/DW1\n" +
+		"\tvalues from the dump without the read-only and ignored fields\n")
+
+	corebootVersion := flag.String("coreboot-version", "",
+		"use the macro names, argument order and spelling of the coreboot release:\n"+
+		"\t" + strings.Join(config.CorebootVersionsGet(), ", ") + "\n"+
+		"\tthe native spelling of the platform is used by default\n")
+
+	layout := flag.String("layout", "gpio.h", "set output files layout:\n"+
+		"\tgpio.h           - single file with the pad configuration table (default)\n"+
+		"\tcoreboot-variant - baseboard gpio.c, gpio.h and variants.h in the -dir directory\n")
+
+	outputDir := flag.String("dir",
+		"generate",
+		"the path to the directory for the coreboot-variant layout files\n")
+
+	baseFileName := flag.String("base",
+		"",
+		"the path to the baseboard gpio.c: generate variant_override_gpio_table()\n" +
+		"\twith the pads from the dump that differ from the baseboard\n")
+
+	updateFileName := flag.String("update",
+		"",
+		"the path to the existing coreboot gpio.c: generate a unified diff with\n" +
+		"\tthe macros whose register meaning differs from the dump to the -o file\n" +
+		"\t(generate/gpio.diff by default)\n")
+
+	writeFlag := flag.Bool("write",
+		false,
+		"apply the changes to the file specified with the -update option\n")
+
+	pinNumbersFlag := flag.Bool("pin-numbers",
+		false,
+		"add the Linux pinctrl pin number and gpiochip offset to the comments with\n" +
+		"\tthe community-relative pad index used by ACPI:\n" +
+		"\t/* SLP_S0# (community 0, pad 26, pin 26, gpio 26) */\n" +
+		"\tuse \"intelp2m pin GPP_C6\" to print the numbers of a single pad\n")
+
+	earlyPads := flag.String("early",
+		"",
+		"comma-separated list of pads for variant_early_gpio_table(),\n" +
+		"\te.g. -early GPP_B14,GPP_C22\n")
+
 	flag.Parse()
 
 	config.IgnoredFieldsFlagSet(*ignFlag)
 	config.NonCheckingFlagSet(*nonCheckFlag)
+	config.AssertFlagSet(*assertFlag)
+	config.PinNumbersFlagSet(*pinNumbersFlag)
 
 	if *infoLevel1 {
 		config.InfoLevelSet(1)
@@ -113,8 +203,45 @@ func main() {
 		os.Exit(1)
 	}
 
+	if config.IsPlatformLegacy() && *outputFileName == "generate/gpio.h" {
+		*outputFileName = "generate/gpio.c"
+	}
+
+	if *updateFileName != "" && *outputFileName == "generate/gpio.h" {
+		// the unified diff for patch or git apply
+		*outputFileName = "generate/gpio.diff"
+	}
+
+	if *sku != "" && config.SkuSet(*sku) != 0 {
+		fmt.Printf("Error: invalid SKU -%s for the platform -%s!\n", *sku, *platform)
+		os.Exit(1)
+	}
+
+	if flag.Arg(0) == "pin" {
+		// intelp2m pin GPP_C6
+		if err := printPinNumbers(flag.Args()[1:]); err != nil {
+			fmt.Printf("Error! %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *corebootVersion != "" && config.CorebootVersionSet(*corebootVersion) != 0 {
+		fmt.Printf("Error! Unknown coreboot release -%s!\n", *corebootVersion)
+		os.Exit(1)
+	}
+
+	if config.LayoutSet(*layout) != 0 {
+		fmt.Printf("Error! Unknown output layout -%s!\n", *layout)
+		os.Exit(1)
+	}
+
 	fmt.Println("Log file:", *inputFileName)
-	fmt.Println("Output generated file:", *outputFileName)
+	if config.IsVariantLayoutUsed() {
+		fmt.Println("Output directory:", *outputDir)
+	} else {
+		fmt.Println("Output generated file:", *outputFileName)
+	}
 
 	inputRegDumpFile, err := os.Open(*inputFileName)
 	if err != nil {
@@ -127,6 +254,58 @@ func main() {
 		os.Exit(1)
 	}
 
+	if config.IsAssertFlagUsed() && (config.IsFspStyleMacro() || config.IsRawFields()) {
+		fmt.Printf("Error! FSP-style macros and raw values can not be checked with _Static_assert()!\n")
+		os.Exit(1)
+	}
+
+	if config.IsPlatformLegacy() && (config.TemplateGet() != config.TempInteltool ||
+			config.IsVariantLayoutUsed() || config.IsAssertFlagUsed() ||
+			*baseFileName != "" || *updateFileName != "") {
+		fmt.Printf("Error! Only the inteltool dump and gpio.c are supported for the platform -%s!\n",
+				*platform)
+		os.Exit(1)
+	}
+
+	defer inputRegDumpFile.Close()
+	config.InputRegDumpFile = inputRegDumpFile
+
+	parser := parser.ParserData{}
+	parser.Parse()
+
+	if *updateFileName != "" {
+		err = updateGpioFile(&parser, *updateFileName, *writeFlag, *outputFileName)
+		if err != nil {
+			fmt.Printf("Error! Can not update the file: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *baseFileName != "" {
+		// variants/<variant>/gpio.c
+		err = generateVariantOverride(&parser, *baseFileName, *outputFileName)
+		if err != nil {
+			fmt.Printf("Error! Can not create the variant override table: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if config.IsVariantLayoutUsed() {
+		// variants/baseboard/gpio.c, gpio.h and variants.h
+		var early []string
+		if *earlyPads != "" {
+			early = strings.Split(*earlyPads, ",")
+		}
+		err = generateVariantLayout(&parser, *outputDir, early)
+		if err != nil {
+			fmt.Printf("Error! Can not create the baseboard files: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// create dir for output files
 	err = os.MkdirAll("generate", os.ModePerm)
 	if err != nil {
@@ -140,15 +319,8 @@ func main() {
 		fmt.Printf("Error: unable to generate GPIO config file!\n")
 		os.Exit(1)
 	}
-
-	defer inputRegDumpFile.Close()
 	defer outputGenFile.Close()
-
 	config.OutputGenFile = outputGenFile
-	config.InputRegDumpFile = inputRegDumpFile
-
-	parser := parser.ParserData{}
-	parser.Parse()
 
 	// gpio.h
 	err = generateOutputFile(&parser)
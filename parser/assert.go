@@ -0,0 +1,77 @@
+package parser
+
+import "fmt"
+
+import "../platforms/common"
+import "../config"
+
+// The reset is not compared: the dump contains PADRSTCFG as programmed in the
+// hardware (DW0 bits 31:30), while PAD_RESET() sets the logical reset
+// (PAD_CFG0_LOGICAL_RESET_*) that the SoC code translates with the reset map of
+// the community only when it programs the pad. The field next to it (bits 29:28)
+// is excluded too, since the logical reset is placed there by some releases.
+const padAssertResetMask uint32 = common.PadRstCfgMask | (0x3 << 28)
+
+// padAssertFprint - print to file the compile-time check that the macro of the
+// pad expands to the register values from the dump. Read-only fields, fields
+// that were ignored when the macro was generated and the reset are not compared.
+// _Static_assert((PAD_CFG_NF(GPP_A1, NONE, DEEP, NF1) & 0x...) == 0x..., "GPP_A1");
+func (info *padInfo) padAssertFprint(macro string) {
+	var mask, value uint64
+	for i, number := range []uint8{common.PAD_CFG_DW0, common.PAD_CFG_DW1} {
+		reg := common.GetMacro().Register(number)
+		fieldmask := ^(reg.ReadOnlyFieldsGet() | reg.IgnoredFieldsGet())
+		if number == common.PAD_CFG_DW0 {
+			fieldmask &= ^padAssertResetMask
+		}
+		mask |= uint64(fieldmask) << (32 * uint(i))
+		value |= uint64(reg.ValueGet() & fieldmask) << (32 * uint(i))
+	}
+	info.generate(0, "_Static_assert((%s & 0x%0.16xULL) == 0x%0.16xULL,\n", macro, mask, value)
+	info.generate(0, "\t\"%s: pad_config[0]/[1] differ from the dump\");\n", info.id)
+}
+
+// assertMacroGet - generates the macro of the pad for the compile-time check.
+// Info levels 2 and above add the reference macro and comments to the string,
+// which are not valid in the integer constant expression, so the macro is
+// generated with the info level 0.
+// pad : pad info
+func (parser *ParserData) assertMacroGet(pad *padInfo) string {
+	return bareMacro(parser.platform.GenMacro(pad.id, pad.dw, pad.ownership, pad.lock,
+			pad.interrupt, 0))
+}
+
+// AssertMapFprint - print to file the compile-time checks that the macros of the
+// pad configuration table match the DW0/DW1 register values from the dump. The
+// checks fail the coreboot build if the semantics of the macros in the coreboot
+// headers change.
+func (parser *ParserData) AssertMapFprint() {
+	fmt.Fprint(config.OutputGenFile, `
+/*
+ * Compile-time check that the pad configuration macros match the DW0/DW1
+ * registers from the dump. _PAD_CFG_STRUCT() is temporarily redefined to
+ * pack pad_config[0] and pad_config[1] into a 64-bit constant, pad_config[2]
+ * of _PAD_CFG_STRUCT_3() and the pad reset are not checked.
+ */
+#pragma push_macro("_PAD_CFG_STRUCT")
+#pragma push_macro("_PAD_CFG_STRUCT_3")
+#undef _PAD_CFG_STRUCT
+#undef _PAD_CFG_STRUCT_3
+#define _PAD_CFG_STRUCT(__pad, __config0, __config1) \
+	((uint64_t)(__config1) << 32 | (uint32_t)(__config0))
+#define _PAD_CFG_STRUCT_3(__pad, __config0, __config1, __config2) \
+	_PAD_CFG_STRUCT(__pad, __config0, __config1)
+
+`)
+	for i := range parser.padmap {
+		pad := &parser.padmap[i]
+		if !pad.isPad() || !pad.isHostOwned() {
+			continue
+		}
+		pad.padAssertFprint(parser.assertMacroGet(pad))
+	}
+	fmt.Fprint(config.OutputGenFile, `
+#pragma pop_macro("_PAD_CFG_STRUCT_3")
+#pragma pop_macro("_PAD_CFG_STRUCT")
+`)
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// dieExtract - selects the GPIO controller of the multi-die SoC. The communities
+// of each die are numbered from 0 in the dump, so the community number from the
+// dump is relative to the first community of the die in the descriptors.
+// GPIO Controller IOE
+func (parser *ParserData) dieExtract() {
+	var name string
+	fmt.Sscanf(strings.TrimSpace(parser.line), "GPIO Controller %s", &name)
+	parser.die, parser.dieBase = "", 0
+	for i, community := range parser.platform.CommunitiesGet(parser.groups) {
+		if community.Die != "" && strings.EqualFold(community.Die, name) {
+			parser.die, parser.dieBase = community.Die, i
+			break
+		}
+	}
+	if parser.die == "" {
+		fmt.Printf("Warning: unknown GPIO controller %s!\n", name)
+	}
+	parser.community = parser.dieBase - 1
+	pad := padInfo{function: parser.line, community: parser.community, index: -1}
+	parser.padmap = append(parser.padmap, pad)
+}
+
+// dieLineGet - adds the prefix of the coreboot pad IDs of the current die to the
+// pad and group names from the dump
+// line   : string from the configuration file
+// return : string with the coreboot names
+func (parser *ParserData) dieLineGet(line string) string {
+	if parser.die == "" {
+		return line
+	}
+	for _, community := range parser.platform.CommunitiesGet(parser.groups) {
+		if community.Die != parser.die || community.Prefix == "" {
+			continue
+		}
+		for _, group := range community.Groups {
+			name := strings.TrimPrefix(group.Name, community.Prefix)
+			if name != group.Name && !strings.Contains(line, group.Name) {
+				line = strings.ReplaceAll(line, name, group.Name)
+			}
+		}
+	}
+	return line
+}
+
+// dieCommunityGet - returns the die and the community number within the GPIO
+// controller of the die
+// number : community number in the descriptors of the platform
+// return
+//     string : die name, empty if the SoC has one GPIO controller
+//     int    : community number from the dump
+func (parser *ParserData) dieCommunityGet(number int) (string, int) {
+	communities := parser.platform.CommunitiesGet(parser.groups)
+	if number < 0 || number >= len(communities) || communities[number].Die == "" {
+		return "", number
+	}
+	first := number
+	for first > 0 && communities[first - 1].Die == communities[number].Die {
+		first--
+	}
+	return communities[number].Die, number - first
+}
@@ -0,0 +1,32 @@
+package parser
+
+import "fmt"
+
+import "../platforms/common"
+import "../config"
+
+// padFunctionCheck - uses the pin-mux table of the platform to fill the pad
+// function, if the configuration file does not contain it, and checks the
+// function from the inteltool dump against the pad mode. Only Sunrise Point has
+// the pin-mux table, NativeFunctionGet() of the other platforms is a stub
+// pad : pad info
+func (parser *ParserData) padFunctionCheck(pad *padInfo) {
+	if !pad.isPad() || !config.IsPlatformSunrise() {
+		return
+	}
+	mode := uint8((pad.dw[0] & common.PadModeMask) >> common.PadModeShift)
+	valid, function := parser.platform.NativeFunctionGet(pad.id, mode)
+	if pad.function == "" {
+		if mode == 0 {
+			pad.function = "GPIO"
+		} else if valid && function != "" {
+			pad.function = function
+		}
+		return
+	}
+	if config.TemplateGet() == config.TempInteltool && valid && function != "" &&
+			pad.function != function {
+		fmt.Printf("Warning: %s: the function %s from the dump does not match NF%d = %s!\n",
+				pad.id, pad.function, mode, function)
+	}
+}
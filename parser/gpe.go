@@ -0,0 +1,150 @@
+package parser
+
+import "fmt"
+
+import "../platforms/common"
+import "../config"
+
+// GPE0_DWx fields in the MISCCFG register select the pad groups that are
+// mapped into the GPE0 block
+const (
+	MisccfgGpe0DwShift uint8  = 8
+	MisccfgGpe0DwMask  uint32 = 0xf
+	MisccfgGpe0DwNum   uint8  = 3
+)
+
+// gpeExtract - extract GPE0 routing from MISCCFG and wake configuration from
+//              GPI_GPE_EN and GPI_GPE_STS registers, return true if success
+func (parser *ParserData) gpeExtract() bool {
+	if status, _, _, value := parser.Register("MISCCFG"); status {
+		// the fields are decoded when the SKU is known, since MISCCFG precedes
+		// the pad groups in the dump
+		parser.gpe0 = make([]uint8, MisccfgGpe0DwNum)
+		for dw := uint8(0); dw < MisccfgGpe0DwNum; dw++ {
+			parser.gpe0[dw] = uint8((value >> (MisccfgGpe0DwShift + 4*dw)) & MisccfgGpe0DwMask)
+		}
+		return true
+	}
+	for _, register := range []struct {
+		name string
+		gpe  map[string]uint32
+	}{
+		{"GPI_GPE_EN_GPP_", parser.gpeen},
+		{"GPI_GPE_STS_GPP_", parser.gpests},
+	} {
+		if status, _, _, value := parser.Register(register.name); status {
+			_, group := parser.platform.GroupNameExtract(parser.line)
+			register.gpe[group] = value
+			return true
+		}
+	}
+	return false
+}
+
+// gpe0DwFirst - returns the number of the first gpe0_dw register in devicetree.
+// On Apollo Lake and Gemini Lake, GPE0 DW0 contains the fixed events, so the
+// GPE0_DW0/1/2 fields of MISCCFG are set with gpe0_dw1/2/3, see
+// src/soc/intel/apollolake/chip.h
+func gpe0DwFirst() int {
+	if config.IsPlatformApollo() || config.IsPlatformGemini() {
+		return 1
+	}
+	return 0
+}
+
+// padGpeGroupGet - returns the GPE group of the pad. The pads of Apollo Lake are
+// not divided into groups, their GPE group is the range of 32 pads within the
+// community, e.g. PMC_GPE_N_63_32
+// pad : pad info
+// return
+//     bool   : true if the GPE group of the pad is known
+//     string : group identifier
+func (parser *ParserData) padGpeGroupGet(pad *padInfo) (bool, string) {
+	if !config.IsPlatformApollo() {
+		return parser.platform.GroupNameExtract(pad.id)
+	}
+	valid, community := parser.communityGet(pad.community)
+	if !valid || pad.index < 0 {
+		return false, ""
+	}
+	first := pad.index / 32 * 32
+	return true, fmt.Sprintf("PMC_GPE_%s_%d_%d", community.Name, first + 31, first)
+}
+
+// isGroupInGpe0 - returns true if the pad group is mapped into the GPE0 block or
+// the group of the pad is unknown
+// pad : pad info
+func (parser *ParserData) isGroupInGpe0(pad *padInfo) bool {
+	valid, group := parser.padGpeGroupGet(pad)
+	if !valid {
+		return true
+	}
+	for _, field := range parser.gpe0 {
+		if _, gpe := parser.gpe0GroupGet(field); gpe == group {
+			return true
+		}
+	}
+	return false
+}
+
+// gpe0GroupGet - returns the group selected by the GPE0_DWx field of MISCCFG
+// field : GPE0_DWx field value
+// return
+//     bool   : true if the group is known
+//     string : group identifier or the field value if the group is unknown
+func (parser *ParserData) gpe0GroupGet(field uint8) (bool, string) {
+	if valid, group := parser.platform.GpeGroupNameGet(field); valid {
+		return true, group
+	}
+	return false, fmt.Sprintf("0x%x", field)
+}
+
+// GpeFprint - print to file the devicetree settings for the GPE0 routing and the
+// list of the wake sources as a comment. Prints a warning for each pad routed to
+// SCI whose group is not mapped into GPE0.
+func (parser *ParserData) GpeFprint() {
+	var wake []string
+	for _, pad := range parser.padmap {
+		if !pad.isPad() || !pad.isHostOwned() {
+			continue
+		}
+		if valid, enabled := parser.padRegisterBitGet(parser.gpeen, pad.id); valid && enabled {
+			str := pad.id
+			if _, status := parser.padRegisterBitGet(parser.gpests, pad.id); status {
+				str += " (GPI_GPE_STS set)"
+			}
+			wake = append(wake, str)
+		}
+		if parser.gpe0 != nil && pad.dw[0]&common.InputRouteSCIMask != 0 &&
+				!parser.isGroupInGpe0(&pad) {
+			fmt.Printf("Warning: %s is routed to SCI, but its group is not mapped"+
+					" into GPE0_DW0/1/2!\n", pad.id)
+		}
+	}
+	if parser.gpe0 == nil && len(wake) == 0 {
+		return
+	}
+
+	config.OutputGenFile.WriteString("\n/*\n")
+	if parser.gpe0 != nil {
+		config.OutputGenFile.WriteString(" * GPE0 routing from MISCCFG for devicetree.cb:\n")
+		for dw, field := range parser.gpe0 {
+			valid, group := parser.gpe0GroupGet(field)
+			if !valid {
+				fmt.Printf("Warning: unknown group 0x%x in GPE0_DW%d of MISCCFG!\n", field, dw)
+			}
+			fmt.Fprintf(config.OutputGenFile, " *\tregister \"gpe0_dw%d\" = \"%s\"\n",
+					dw + gpe0DwFirst(), group)
+		}
+	}
+	if len(wake) != 0 {
+		if parser.gpe0 != nil {
+			config.OutputGenFile.WriteString(" *\n")
+		}
+		config.OutputGenFile.WriteString(" * Wake sources from GPI_GPE_EN:\n")
+		for _, str := range wake {
+			fmt.Fprintf(config.OutputGenFile, " *\t%s\n", str)
+		}
+	}
+	config.OutputGenFile.WriteString(" */\n")
+}
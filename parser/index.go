@@ -0,0 +1,89 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// padIndexCheck - calculates the index of each pad within its community from the
+// offset of the pad configuration registers and checks the dump against the
+// community descriptors of the platform: pads with misaligned offsets, pads
+// whose name does not match the offset, duplicated and missing pads.
+func (parser *ParserData) padIndexCheck() {
+	communities := parser.platform.CommunitiesGet(parser.groups)
+	if communities == nil {
+		return
+	}
+	ids := make(map[string]bool)
+	offsets := make(map[string]string)
+	for i := range parser.padmap {
+		pad := &parser.padmap[i]
+		if pad.id == "" {
+			continue
+		}
+		if ids[pad.id] {
+			fmt.Printf("Warning: pad %s is duplicated in the dump!\n", pad.id)
+		}
+		ids[pad.id] = true
+		if pad.community < 0 {
+			// the dump does not contain the community headers
+			continue
+		}
+		if pad.community >= len(communities) {
+			fmt.Printf("Warning: pad %s: unknown GPIO community %d!\n", pad.id, pad.community)
+			continue
+		}
+		key := fmt.Sprintf("%d:0x%x", pad.community, pad.offset)
+		if id, exist := offsets[key]; exist {
+			fmt.Printf("Warning: pad %s: offset 0x%04x in community %d is already used by %s!\n",
+					pad.id, pad.offset, pad.community, id)
+		}
+		offsets[key] = pad.id
+		community := communities[pad.community]
+		valid, index := community.PadIndexGet(pad.offset)
+		if !valid {
+			fmt.Printf("Warning: pad %s: offset 0x%04x in community %d is invalid!\n",
+					pad.id, pad.offset, pad.community)
+			continue
+		}
+		pad.index = index
+		if len(community.Groups) == 0 {
+			// the names of the pads are not known
+			continue
+		}
+		if valid, name := community.PadNameGet(index); !valid || (name != "" && name != pad.id) {
+			if !valid {
+				name = "no pad"
+			}
+			fmt.Printf("Warning: pad %s: offset 0x%04x in community %d corresponds to %s!\n",
+					pad.id, pad.offset, pad.community, name)
+		}
+	}
+
+	// Only the groups from the dump are checked, since the dump of the other
+	// PCH SKU may not have some of the groups
+	checked := make(map[string]bool)
+	for _, name := range parser.groups {
+		if checked[name] {
+			continue
+		}
+		checked[name] = true
+		for _, community := range communities {
+			for _, group := range community.Groups {
+				if group.Name != name {
+					continue
+				}
+				var missing []string
+				for number := 0; number < group.PadNumGet(); number++ {
+					if id := group.PadNameGet(number); !ids[id] {
+						missing = append(missing, id)
+					}
+				}
+				if len(missing) != 0 {
+					fmt.Printf("Warning: %d pads of the group %s are missing in the dump: %s\n",
+							len(missing), name, strings.Join(missing, ", "))
+				}
+			}
+		}
+	}
+}
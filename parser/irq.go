@@ -0,0 +1,101 @@
+package parser
+
+import "fmt"
+
+import "../platforms/common"
+import "../platforms/block"
+import "../config"
+
+// padTrigGet - returns the RX Level/Edge Configuration of the pad
+func (info *padInfo) padTrigGet() string {
+	var trig = map[uint32]string{
+		common.TRIG_LEVEL:       "LEVEL",
+		common.TRIG_EDGE_SINGLE: "EDGE_SINGLE",
+		common.TRIG_OFF:         "OFF",
+		common.TRIG_EDGE_BOTH:   "EDGE_BOTH",
+	}
+	return trig[(info.dw[0]&common.RxLevelEdgeConfigurationMask)>>common.RxLevelEdgeConfigurationShift]
+}
+
+// padModeMaskGet - returns the PMODE field mask of the platform
+func padModeMaskGet() uint32 {
+	if config.IsPlatformTiger() || config.IsPlatformAlder() || config.IsPlatformMeteor() ||
+			config.IsPlatformElkhart() || config.IsPlatformJasper() {
+		return block.PadModeMask
+	}
+	return common.PadModeMask
+}
+
+// isGpioInput - returns true if the pad is in GPIO mode and the RX buffer is enabled
+func (info *padInfo) isGpioInput() bool {
+	const rxDisable = 0x2 << common.RxTxBufDisableShift
+	return info.dw[0]&padModeMaskGet() == 0 && info.dw[0]&rxDisable == 0
+}
+
+// InterruptFprint - print to file as a comment the list of the GPIO inputs owned
+// by the GPIO driver whose interrupts are enabled in the GPI_IE register, that is,
+// the interrupts that the OS will see as enabled at handoff
+func (parser *ParserData) InterruptFprint() {
+	var enabled []string
+	for _, pad := range parser.padmap {
+		if !pad.isPad() || !pad.isHostOwned() || !pad.isGpioInput() ||
+				pad.ownership != common.PAD_OWN_DRIVER ||
+				pad.interrupt != common.PAD_INT_ENABLE {
+			continue
+		}
+		str := fmt.Sprintf("%s - %s", pad.id, pad.padTrigGet())
+		if _, status := parser.padRegisterBitGet(parser.gpiis, pad.id); status {
+			str += " (GPI_IS set)"
+		}
+		enabled = append(enabled, str)
+	}
+	if len(enabled) == 0 {
+		return
+	}
+	config.OutputGenFile.WriteString("\n/*\n * Driver interrupts enabled at handoff (GPI_IE):\n")
+	for _, str := range enabled {
+		fmt.Fprintf(config.OutputGenFile, " *\t%s\n", str)
+	}
+	config.OutputGenFile.WriteString(" */\n")
+}
+
+// IrqMapFprint - print to file as a comment the IOxAPIC IRQ map of the pads routed
+// to IOxAPIC: pad -> IRQ line from INTSEL -> trigger/polarity. Prints a warning for
+// each IRQ line shared by several pads with different trigger or polarity.
+func (parser *ParserData) IrqMapFprint() {
+	if config.TemplateGet() != config.TempInteltool {
+		// INTSEL is only available in the inteltool dump
+		return
+	}
+	var irqmap []string
+	irqs := make(map[uint8]*padInfo)
+	for i := range parser.padmap {
+		pad := &parser.padmap[i]
+		if !pad.isPad() || !pad.isHostOwned() || !pad.isGpioInput() ||
+				pad.dw[0]&common.InputRouteIOxApicMask == 0 {
+			continue
+		}
+		polarity := "HIGH"
+		if pad.dw[0]&common.RxInvertMask != 0 {
+			polarity = "LOW"
+		}
+		irqmap = append(irqmap, fmt.Sprintf("%s -> IRQ %d -> %s/%s",
+				pad.id, pad.intsel, pad.padTrigGet(), polarity))
+
+		mask := common.RxLevelEdgeConfigurationMask | common.RxInvertMask
+		if shared, valid := irqs[pad.intsel]; !valid {
+			irqs[pad.intsel] = pad
+		} else if shared.dw[0]&mask != pad.dw[0]&mask {
+			fmt.Printf("Warning: IRQ %d is shared by %s and %s with different trigger"+
+					" settings!\n", pad.intsel, shared.id, pad.id)
+		}
+	}
+	if len(irqmap) == 0 {
+		return
+	}
+	config.OutputGenFile.WriteString("\n/*\n * IOxAPIC IRQ map (INTSEL):\n")
+	for _, str := range irqmap {
+		fmt.Fprintf(config.OutputGenFile, " *\t%s\n", str)
+	}
+	config.OutputGenFile.WriteString(" */\n")
+}
@@ -0,0 +1,143 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+import "../platforms/cpt"
+import "../config"
+
+// legacyPart - table of the GPIO set for one register of the bank
+// name   : member of the set in struct pch_gpio_map
+// reg    : register name in the inteltool dump
+// macros : values of the pad field for the bit equal to 0 and 1
+// mask   : pads whose value is added to the table
+type legacyPart struct {
+	name   string
+	reg    string
+	macros [2]string
+	mask   uint32
+}
+
+// legacyExtract - extract the GPIO registers of the 6/7/8/9-series PCH from the
+//                 inteltool dump, return true if success
+// gpiobase+0x0000: 0x1f7ff7fd (GPIO_USE_SEL)
+func (parser *ParserData) legacyExtract() bool {
+	status, name, _, value := parser.Register("gpiobase+")
+	if status {
+		parser.legacy[name] = value
+	}
+	return status
+}
+
+// legacyParse - read the GPIO registers of the platform without the pad
+//               configuration registers from the inteltool log file
+func (parser *ParserData) legacyParse() {
+	if !config.IsPlatformCougar() {
+		// Bay Trail and Braswell, see soc.go
+		parser.socParse()
+		return
+	}
+	parser.legacy = make(map[string]uint32)
+	scanner := bufio.NewScanner(config.InputRegDumpFile)
+	for scanner.Scan() {
+		parser.line = scanner.Text()
+		parser.legacyExtract()
+	}
+	if _, valid := parser.legacy[cpt.BanksGet()[0].Mode]; !valid {
+		if _, valid := parser.legacy[cpt.LpOwnership]; valid {
+			fmt.Println("Error! The dump contains the per-pad LP_GPIO registers of the " +
+					"Lynx Point-LP or Wildcat Point-LP PCH, which are not supported!")
+			os.Exit(1)
+		}
+		fmt.Println("Warning: the dump does not contain the GPIO registers of the PCH!")
+	}
+	fmt.Println("...done!")
+}
+
+// legacyPartsGet - returns the tables of the GPIO set. Direction is only for the
+// pads in the GPIO mode and level is only for the outputs. Reset, invert and
+// blink tables contain only the pads with the bit set, like the default values
+// of the registers. The registers that are not in the dump are skipped.
+// bank : GPIO registers of the set
+func (parser *ParserData) legacyPartsGet(bank cpt.Bank) []legacyPart {
+	mode := parser.legacy[bank.Mode]
+	direction := parser.legacy[bank.Direction]
+	output := mode &^ direction
+	parts := []legacyPart{
+		{"mode", bank.Mode, [2]string{"GPIO_MODE_NATIVE", "GPIO_MODE_GPIO"}, 0xffffffff},
+		{"direction", bank.Direction, [2]string{"GPIO_DIR_OUTPUT", "GPIO_DIR_INPUT"}, mode},
+		{"level", bank.Level, [2]string{"GPIO_LEVEL_LOW", "GPIO_LEVEL_HIGH"}, output},
+		{"reset", bank.Reset, [2]string{"GPIO_RESET_PWROK", "GPIO_RESET_RSMRST"},
+				parser.legacy[bank.Reset]},
+		{"invert", bank.Invert, [2]string{"GPIO_NO_INVERT", "GPIO_INVERT"},
+				mode & direction & parser.legacy[bank.Invert]},
+		{"blink", bank.Blink, [2]string{"GPIO_NO_BLINK", "GPIO_BLINK"},
+				output & parser.legacy[bank.Blink]},
+	}
+	var tables []legacyPart
+	for _, part := range parts {
+		if _, valid := parser.legacy[part.reg]; valid && part.mask != 0 {
+			part.mask &= uint32((uint64(1) << uint(bank.Size)) - 1)
+			tables = append(tables, part)
+		}
+	}
+	return tables
+}
+
+// LegacyMapFprint - print to file the GPIO tables of struct pch_gpio_map for
+// src/southbridge/intel/common/gpio.c or the tables of the SoC
+func (parser *ParserData) LegacyMapFprint() {
+	if !config.IsPlatformCougar() {
+		parser.socMapFprint()
+		return
+	}
+	config.OutputGenFile.WriteString(`/* SPDX-License-Identifier: GPL-2.0-only */
+
+#include <southbridge/intel/common/gpio.h>
+
+/* GPIO configuration was generated automatically using intelp2m utility */
+`)
+	var sets [][]legacyPart
+	var banks []cpt.Bank
+	for _, bank := range cpt.BanksGet() {
+		if _, valid := parser.legacy[bank.Mode]; !valid {
+			continue
+		}
+		tables := parser.legacyPartsGet(bank)
+		for _, part := range tables {
+			value := parser.legacy[part.reg]
+			fmt.Fprintf(config.OutputGenFile, "\nstatic const struct pch_gpio_set%d pch_gpio_set%d_%s = {\n",
+					bank.Set, bank.Set, part.name)
+			if config.InfoLevelGet() >= 1 {
+				fmt.Fprintf(config.OutputGenFile, "\t/* %s: 0x%0.8x */\n", part.reg, value)
+			}
+			for bit := 0; bit < bank.Size; bit++ {
+				if part.mask & (1 << uint(bit)) != 0 {
+					fmt.Fprintf(config.OutputGenFile, "\t.gpio%d = %s,\n",
+							(bank.Set - 1) * 32 + bit, part.macros[(value >> uint(bit)) & 1])
+				}
+			}
+			config.OutputGenFile.WriteString("};\n")
+		}
+		sets = append(sets, tables)
+		banks = append(banks, bank)
+	}
+
+	config.OutputGenFile.WriteString("\nconst struct pch_gpio_map mainboard_gpio_map = {\n")
+	for i, bank := range banks {
+		fmt.Fprintf(config.OutputGenFile, "\t.set%d = {\n", bank.Set)
+		for _, part := range sets[i] {
+			tabs := "\t"
+			if len(part.name) < 7 {
+				tabs = "\t\t"
+			}
+			fmt.Fprintf(config.OutputGenFile, "\t\t.%s%s= &pch_gpio_set%d_%s,\n",
+					part.name, tabs, bank.Set, part.name)
+		}
+		config.OutputGenFile.WriteString("\t},\n")
+	}
+	config.OutputGenFile.WriteString("};\n")
+}
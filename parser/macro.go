@@ -0,0 +1,610 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+import "../platforms/common"
+
+// PAD_CFG_OWN_GPIO(DRIVER) - the software ownership flag that coreboot keeps
+// in the reserved bit of the pad_config[1]
+const padCfgOwnGpioDriver uint32 = 0x1 << 4
+
+// field - macro argument decoder
+// shift  : the position of the bit field in the register
+// values : map of argument names to the bit field values
+type field struct {
+	shift  uint8
+	values map[string]uint32
+}
+
+// value - returns the bit field value for the macro argument
+func (fld field) value(arg string) (uint32, error) {
+	if val, valid := fld.values[arg]; valid {
+		return val << fld.shift, nil
+	}
+	return 0, fmt.Errorf("unknown argument %s", arg)
+}
+
+var padFunc = field{
+	shift  : common.PadModeShift,
+	values : map[string]uint32{
+		"GPIO": 0, "NF1": 1, "NF2": 2, "NF3": 3, "NF4": 4, "NF5": 5, "NF6": 6, "NF7": 7,
+	},
+}
+
+var padReset = field{
+	shift  : common.PadRstCfgShift,
+	values : map[string]uint32{
+		"PWROK"  : common.RST_PWROK,
+		"DEEP"   : common.RST_DEEP,
+		"PLTRST" : common.RST_PLTRST,
+		"RSMRST" : common.RST_RSMRST,
+	},
+}
+
+var padTrig = field{
+	shift  : common.RxLevelEdgeConfigurationShift,
+	values : map[string]uint32{
+		"LEVEL"       : common.TRIG_LEVEL,
+		"EDGE_SINGLE" : common.TRIG_EDGE_SINGLE,
+		"OFF"         : common.TRIG_OFF,
+		"EDGE_BOTH"   : common.TRIG_EDGE_BOTH,
+	},
+}
+
+var padRxPol = field{
+	shift  : common.RxInvertShift,
+	values : map[string]uint32{"NONE": 0, "INVERT": 1, "YES": 1},
+}
+
+var padBuf = field{
+	shift  : common.RxTxBufDisableShift,
+	values : map[string]uint32{
+		"NO_DISABLE": 0, "TX_DISABLE": 1, "RX_DISABLE": 2, "TX_RX_DISABLE": 3,
+	},
+}
+
+var padIrqRoute = field{
+	shift  : 0,
+	values : map[string]uint32{
+		"IOAPIC" : common.InputRouteIOxApicMask,
+		"SCI"    : common.InputRouteSCIMask,
+		"SMI"    : common.InputRouteSMIMask,
+		"NMI"    : common.InputRouteNMIMask,
+	},
+}
+
+// Both the common block and the Sunrise spellings of the termination
+var padPull = field{
+	shift  : common.TermShift,
+	values : map[string]uint32{
+		"NONE"   : 0x0,
+		"DN_5K"  : 0x2, "5K_PD"  : 0x2,
+		"DN_20K" : 0x4, "20K_PD" : 0x4,
+		"UP_1K"  : 0x9, "1K_PU"  : 0x9,
+		"UP_5K"  : 0xa, "5K_PU"  : 0xa,
+		"UP_2K"  : 0xb, "2K_PU"  : 0xb,
+		"UP_20K" : 0xc, "20K_PU" : 0xc,
+		"UP_667" : 0xd, "667_PU" : 0xd,
+		"NATIVE" : 0xf,
+	},
+}
+
+var padIOSstate = field{
+	shift  : common.IOStandbyStateShift,
+	values : map[string]uint32{
+		"TxLASTRxE"  : common.TxLASTRxE,
+		"Tx0RxDCRx0" : common.Tx0RxDCRx0,
+		"Tx0RxDCRx1" : common.Tx0RxDCRx1,
+		"Tx1RxDCRx0" : common.Tx1RxDCRx0,
+		"Tx1RxDCRx1" : common.Tx1RxDCRx1,
+		"Tx0RxE"     : common.Tx0RxE,
+		"Tx1RxE"     : common.Tx1RxE,
+		"HIZCRx0"    : common.HIZCRx0,
+		"HIZCRx1"    : common.HIZCRx1,
+		"TxDRxE"     : common.TxDRxE,
+		"IGNORE"     : common.StandbyIgnore,
+	},
+}
+
+var padIOSterm = field{
+	shift  : common.IOStandbyTerminationShift,
+	values : map[string]uint32{
+		"SAME"    : common.IOSTERM_SAME,
+		"DISPUPD" : common.IOSTERM_DISPUPD,
+		"ENPD"    : common.IOSTERM_ENPD,
+		"ENPU"    : common.IOSTERM_ENPU,
+	},
+}
+
+var padDebounce = field{
+	shift  : common.DebounceShift,
+	values : map[string]uint32{
+		"DEBOUNCE_8_RTC"  : 0x3, "DEBOUNCE_16_RTC" : 0x4, "DEBOUNCE_32_RTC"  : 0x5,
+		"DEBOUNCE_64_RTC" : 0x6, "DEBOUNCE_128_RTC": 0x7, "DEBOUNCE_256_RTC" : 0x8,
+		"DEBOUNCE_512_RTC": 0x9, "DEBOUNCE_1K_RTC" : 0xa, "DEBOUNCE_2K_RTC"  : 0xb,
+		"DEBOUNCE_4K_RTC" : 0xc, "DEBOUNCE_8K_RTC" : 0xd, "DEBOUNCE_16K_RTC" : 0xe,
+		"DEBOUNCE_32K_RTC": 0xf,
+	},
+}
+
+var padOwn = field{
+	shift  : 0,
+	values : map[string]uint32{"ACPI": 0, "DRIVER": padCfgOwnGpioDriver},
+}
+
+// cbMacro - coreboot PAD_CFG_* macro definition
+// params : names of the macro parameters after the pad ID
+// dw0    : constant part of the PAD_CFG_DW0 register
+// dw1    : constant part of the PAD_CFG_DW1 register
+// dw2    : constant part of the PAD_CFG_DW2 register, empty if the macro does
+//          not configure it
+type cbMacro struct {
+	params []string
+	dw0    string
+	dw1    string
+	dw2    string
+}
+
+// See src/soc/intel/common/block/include/intelblocks/gpio_defs.h
+// The definitions use the same bit field macros as the coreboot headers, the
+// parameters are replaced with the arguments before evaluating them.
+var cbMacros = map[string]cbMacro{
+	"PAD_NC": {
+		params : []string{"pull"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(DEEP) | PAD_TRIG(OFF) | PAD_BUF(TX_RX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxDRxE)",
+	},
+	"PAD_CFG_GPO": {
+		params : []string{"val", "rst"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(OFF) | PAD_BUF(RX_DISABLE) | val",
+		dw1    : "PAD_PULL(NONE) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_TERM_GPO": {
+		params : []string{"val", "pull", "rst"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(OFF) | PAD_BUF(RX_DISABLE) | val",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPO_GPIO_DRIVER": {
+		params : []string{"val", "rst", "pull"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(OFF) | PAD_BUF(RX_DISABLE) | val",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE) | PAD_CFG_OWN_GPIO(DRIVER)",
+	},
+	"PAD_CFG_GPO_IOSSTATE_IOSTERM": {
+		params : []string{"val", "rst", "pull", "iosstate", "iosterm"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(OFF) | PAD_BUF(RX_DISABLE) | val",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(iosstate) | PAD_IOSTERM(iosterm)",
+	},
+	"PAD_CFG_NF": {
+		params : []string{"pull", "rst", "func"},
+		dw0    : "PAD_RESET(rst) | PAD_FUNC(func)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_NF_1V8": {
+		params : []string{"pull", "rst", "func"},
+		dw0    : "PAD_RESET(rst) | PAD_FUNC(func)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE) | PAD_CFG1_TOL_1V8",
+	},
+	"PAD_CFG_NF_IOSSTATE": {
+		params : []string{"pull", "rst", "func", "iosstate"},
+		dw0    : "PAD_RESET(rst) | PAD_FUNC(func)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(iosstate)",
+	},
+	"PAD_CFG_NF_IOSSTATE_IOSTERM": {
+		params : []string{"pull", "rst", "func", "iosstate", "iosterm"},
+		dw0    : "PAD_RESET(rst) | PAD_FUNC(func)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(iosstate) | PAD_IOSTERM(iosterm)",
+	},
+	"PAD_CFG_NF_IOSTANDBY_IGNORE": {
+		params : []string{"pull", "rst", "func"},
+		dw0    : "PAD_RESET(rst) | PAD_FUNC(func)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(IGNORE)",
+	},
+	"PAD_CFG_GPI": {
+		params : []string{"pull", "rst"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(OFF) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_TRIG_OWN": {
+		params : []string{"pull", "rst", "trig", "own"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(own) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_TRIG_IOSSTATE_OWN": {
+		params : []string{"pull", "rst", "trig", "iosstate", "own"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(own) | PAD_IOSSTATE(iosstate)",
+	},
+	"PAD_CFG_GPI_TRIG_IOS_OWN": {
+		params : []string{"pull", "rst", "trig", "iosstate", "iosterm", "own"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(own) | PAD_IOSSTATE(iosstate) | PAD_IOSTERM(iosterm)",
+	},
+	"PAD_CFG_GPI_GPIO_DRIVER": {
+		params : []string{"pull", "rst"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(OFF) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(DRIVER) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_INT": {
+		params : []string{"pull", "rst", "trig"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(DRIVER) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_INT_DEBOUNCE": {
+		params : []string{"pull", "rst", "trig", "deb"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(DRIVER) | PAD_IOSSTATE(TxLASTRxE)",
+		dw2    : "PAD_CFG2_DEBEN | PAD_CFG2_##deb",
+	},
+	"PAD_CFG_GPI_APIC": {
+		params : []string{"pull", "rst", "trig", "inv"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(IOAPIC) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_APIC_INVERT": {
+		params : []string{"pull", "rst"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(LEVEL) | PAD_RX_POL(INVERT) | " +
+				"PAD_IRQ_ROUTE(IOAPIC) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_APIC_LOW": {
+		params : []string{"pull", "rst"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(LEVEL) | PAD_RX_POL(INVERT) | " +
+				"PAD_IRQ_ROUTE(IOAPIC) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_APIC_HIGH": {
+		params : []string{"pull", "rst"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(LEVEL) | PAD_RX_POL(NONE) | " +
+				"PAD_IRQ_ROUTE(IOAPIC) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_APIC_IOS": {
+		params : []string{"pull", "rst", "trig", "inv", "iosstate", "iosterm"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(IOAPIC) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(iosstate) | PAD_IOSTERM(iosterm)",
+	},
+	"PAD_CFG_GPI_SCI": {
+		params : []string{"pull", "rst", "trig", "inv"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(SCI) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_SCI_DEBOUNCE": {
+		params : []string{"pull", "rst", "trig", "inv", "deb"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(SCI) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+		dw2    : "PAD_CFG2_DEBEN | PAD_CFG2_##deb",
+	},
+	"PAD_CFG_GPI_SCI_IOS": {
+		params : []string{"pull", "rst", "trig", "inv", "iosstate", "iosterm"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(SCI) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(iosstate) | PAD_IOSTERM(iosterm)",
+	},
+	"PAD_CFG_GPI_ACPI_SCI": {
+		params : []string{"pull", "rst", "inv"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(EDGE_SINGLE) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(SCI) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_SMI": {
+		params : []string{"pull", "rst", "trig", "inv"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(SMI) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_SMI_IOS": {
+		params : []string{"pull", "rst", "trig", "inv", "iosstate", "iosterm"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(SMI) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(iosstate) | PAD_IOSTERM(iosterm)",
+	},
+	"PAD_CFG_GPI_ACPI_SMI": {
+		params : []string{"pull", "rst", "inv"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(EDGE_SINGLE) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(SMI) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_NMI": {
+		params : []string{"pull", "rst", "trig", "inv"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(NMI) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPI_DUAL_ROUTE": {
+		params : []string{"pull", "rst", "trig", "inv", "route1", "route2"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_RX_POL(inv) | " +
+				"PAD_IRQ_ROUTE(route1) | PAD_IRQ_ROUTE(route2) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPIO_BIDIRECT": {
+		params : []string{"val", "pull", "rst", "trig", "own"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_BUF(NO_DISABLE) | val",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(own) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+	"PAD_CFG_GPIO_BIDIRECT_IOS": {
+		params : []string{"val", "pull", "rst", "trig", "iosstate", "iosterm", "own"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(trig) | PAD_BUF(NO_DISABLE) | val",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(own) | PAD_IOSSTATE(iosstate) | PAD_IOSTERM(iosterm)",
+	},
+	"PAD_CFG_GPIO_HI_Z": {
+		params : []string{"pull", "rst", "iosstate", "iosterm"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_BUF(TX_RX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(iosstate) | PAD_IOSTERM(iosterm)",
+	},
+	"PAD_CFG_GPIO_DRIVER_HI_Z": {
+		params : []string{"pull", "rst", "iosstate", "iosterm"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_BUF(TX_RX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_CFG_OWN_GPIO(DRIVER) | PAD_IOSSTATE(iosstate) | " +
+				"PAD_IOSTERM(iosterm)",
+	},
+	"_PAD_CFG_STRUCT": {
+		params : []string{"config0", "config1"},
+		dw0    : "config0",
+		dw1    : "config1",
+	},
+	"_PAD_CFG_STRUCT_3": {
+		params : []string{"config0", "config1", "config2"},
+		dw0    : "config0",
+		dw1    : "config1",
+		dw2    : "config2",
+	},
+}
+
+// Sunrise headers define the short form of the APIC macros without the trigger and
+// the polarity: PAD_CFG_GPI_APIC(pad, pull, rst)
+var cbMacrosShortForm = map[string]cbMacro{
+	"PAD_CFG_GPI_APIC": {
+		params : []string{"pull", "rst"},
+		dw0    : "PAD_FUNC(GPIO) | PAD_RESET(rst) | PAD_TRIG(LEVEL) | PAD_RX_POL(NONE) | " +
+				"PAD_IRQ_ROUTE(IOAPIC) | PAD_BUF(TX_DISABLE)",
+		dw1    : "PAD_PULL(pull) | PAD_IOSSTATE(TxLASTRxE)",
+	},
+}
+
+// bitFieldMacros - the bit field macros that can be used in the definitions and in
+// the _PAD_CFG_STRUCT() arguments
+var bitFieldMacros = map[string]field{
+	"PAD_FUNC"         : padFunc,
+	"PAD_RESET"        : padReset,
+	"PAD_TRIG"         : padTrig,
+	"PAD_RX_POL"       : padRxPol,
+	"PAD_BUF"          : padBuf,
+	"PAD_IRQ_ROUTE"    : padIrqRoute,
+	"PAD_PULL"         : padPull,
+	"PAD_IOSSTATE"     : padIOSstate,
+	"PAD_IOSTERM"      : padIOSterm,
+	"PAD_CFG_OWN_GPIO" : padOwn,
+}
+
+// splitArguments - splits the string with the macro arguments and trims them,
+// see common.SplitArguments()
+// str    : string after the opening parenthesis of the macro
+// return
+//     list of the arguments
+//     false if the closing parenthesis was not found
+func splitArguments(str string) ([]string, bool) {
+	args, closed := common.SplitArguments(str)
+	if !closed {
+		return nil, false
+	}
+	for i := range args {
+		args[i] = strings.TrimSpace(args[i])
+	}
+	return args, true
+}
+
+// evaluateTerm - evaluates a single term of the bit fields expression:
+// PAD_FUNC(NF1), PAD_CFG1_TOL_1V8, 0x44000500, (1 << 29), !!1
+func evaluateTerm(term string) (uint32, error) {
+	term = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(term), "!"))
+	if term == "PAD_CFG1_TOL_1V8" {
+		return common.PadTolMask, nil
+	}
+	if term == "PAD_CFG2_DEBEN" {
+		return common.DebounceEnableMask, nil
+	}
+	if strings.HasPrefix(term, "PAD_CFG2_DEBOUNCE_") {
+		return padDebounce.value(strings.TrimPrefix(term, "PAD_CFG2_"))
+	}
+	if strings.HasPrefix(term, "(") && strings.HasSuffix(term, ")") {
+		term = strings.TrimSpace(term[1 : len(term)-1])
+		if operands := strings.Split(term, "<<"); len(operands) == 2 {
+			val, err := evaluateTerm(operands[0])
+			if err != nil {
+				return 0, err
+			}
+			shift, err := evaluateTerm(operands[1])
+			if err != nil {
+				return 0, err
+			}
+			return val << shift, nil
+		}
+		return evaluateExpression(term)
+	}
+	if i := strings.Index(term, "("); i > 0 {
+		fld, valid := bitFieldMacros[strings.TrimSpace(term[:i])]
+		if !valid {
+			return 0, fmt.Errorf("unknown bit field macro %s", term)
+		}
+		args, closed := splitArguments(term[i+1:])
+		if !closed || len(args) != 1 {
+			return 0, fmt.Errorf("invalid bit field macro %s", term)
+		}
+		return fld.value(args[0])
+	}
+	val, err := strconv.ParseUint(strings.TrimRight(term, "uUlL"), 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unknown term %s", term)
+	}
+	return uint32(val), nil
+}
+
+// evaluateExpression - evaluates the bit fields expression combined with " | "
+func evaluateExpression(expression string) (uint32, error) {
+	var value uint32
+	level, start := 0, 0
+	for i, c := range expression + "|" {
+		switch c {
+		case '(':
+			level++
+		case ')':
+			level--
+		case '|':
+			if level != 0 {
+				continue
+			}
+			term, err := evaluateTerm(expression[start:i])
+			if err != nil {
+				return 0, err
+			}
+			value |= term
+			start = i + 1
+		}
+	}
+	return value, nil
+}
+
+// substitute - replaces the macro parameters with the arguments in the expression,
+// the token pasting operator is supported: PAD_CFG2_##deb
+func substitute(expression string, params []string, args []string) string {
+	var result strings.Builder
+	word := ""
+	flush := func() {
+		tokens := strings.Split(word, "##")
+		for t, token := range tokens {
+			for i, param := range params {
+				if token == param {
+					tokens[t] = args[i]
+					break
+				}
+			}
+		}
+		result.WriteString(strings.Join(tokens, ""))
+		word = ""
+	}
+	for _, c := range expression {
+		if tokenCheck(c) {
+			flush()
+			result.WriteRune(c)
+		} else {
+			word += string(c)
+		}
+	}
+	flush()
+	return result.String()
+}
+
+// commentsStrip - replaces the comments in the line with spaces, so the position
+// of the macro in the line does not change
+// line      : string from file with pad config map
+// incomment : the line starts inside a multi-line comment
+// return
+//     line without the comments
+//     true if the multi-line comment is not closed at the end of the line
+func commentsStrip(line string, incomment bool) (string, bool) {
+	code := []byte(line)
+	for i := 0; i < len(code); i++ {
+		next := byte(0)
+		if i+1 < len(code) {
+			next = code[i+1]
+		}
+		switch {
+		case incomment && code[i] == '*' && next == '/':
+			code[i], code[i+1] = ' ', ' '
+			i++
+			incomment = false
+		case incomment:
+			code[i] = ' '
+		case code[i] == '/' && next == '*':
+			code[i], code[i+1] = ' ', ' '
+			i++
+			incomment = true
+		case code[i] == '/' && next == '/':
+			for ; i < len(code); i++ {
+				code[i] = ' '
+			}
+		}
+	}
+	return string(code), incomment
+}
+
+// cbMacroFind - finds the coreboot pad configuration macro in the line, the
+// macros inside the comments are skipped: /* PAD_CFG_GPO(GPP_A4, 0, DEEP), */
+// line   : string from file with pad config map
+// return
+//     macro name
+//     macro arguments
+//     the position of the macro in the line and its length, the length is -1
+//     if the macro continues on the next line
+func cbMacroFind(line string) (name string, args []string, pos int, length int) {
+	code, _ := commentsStrip(line, false)
+	for _, prefix := range []string{"_PAD_CFG_STRUCT", "PAD_CFG_", "PAD_NC"} {
+		start := strings.Index(code, prefix)
+		if start < 0 || (start > 0 && !tokenCheck(rune(code[start-1]))) {
+			continue
+		}
+		open := strings.Index(code[start:], "(")
+		if open < 0 {
+			continue
+		}
+		name = strings.TrimSpace(code[start : start+open])
+		var closed bool
+		if args, closed = splitArguments(code[start+open+1:]); !closed {
+			return name, nil, start, -1
+		}
+		// find the closing parenthesis of the macro
+		level := 0
+		for i, c := range code[start+open:] {
+			if c == '(' {
+				level++
+			} else if c == ')' {
+				if level--; level == 0 {
+					length = open + i + 1
+					break
+				}
+			}
+		}
+		return name, args, start, length
+	}
+	return "", nil, 0, 0
+}
+
+// cbMacroDecode - decodes the coreboot pad configuration macro
+// name : macro name
+// args : macro arguments
+// return
+//     pad ID
+//     register values, DW1 includes PAD_CFG_OWN_GPIO(DRIVER) flag
+//     error
+func cbMacroDecode(name string, args []string) (string, [common.MAX_DW_NUM]uint32, error) {
+	var dw [common.MAX_DW_NUM]uint32
+	definition, valid := cbMacros[name]
+	if short, exist := cbMacrosShortForm[name]; exist && len(args) == len(short.params)+1 {
+		definition, valid = short, true
+	}
+	if !valid {
+		return "", dw, fmt.Errorf("unknown macro %s", name)
+	}
+	if len(args) != len(definition.params)+1 {
+		return "", dw, fmt.Errorf("%s: invalid number of arguments", name)
+	}
+	for number, expression := range []string{definition.dw0, definition.dw1, definition.dw2} {
+		if expression == "" {
+			continue
+		}
+		value, err := evaluateExpression(substitute(expression, definition.params, args[1:]))
+		if err != nil {
+			return "", dw, fmt.Errorf("%s: %v", name, err)
+		}
+		dw[number] = value
+	}
+	return args[0], dw, nil
+}
@@ -0,0 +1,119 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+import "../config"
+
+// padLocate - finds the community and the index of the pad within the community
+// by the pad name, if the offset of the pad configuration registers is unknown
+// id : pad ID string
+// return
+//     bool : true if the pad was found in the community descriptors
+//     int  : community number
+//     int  : pad index
+func (parser *ParserData) padLocate(id string) (bool, int, int) {
+	for i, community := range parser.platform.CommunitiesGet(parser.groups) {
+		if valid, index := community.PadFind(id); valid {
+			return true, i, index
+		}
+	}
+	return false, 0, 0
+}
+
+// padSkuCheck - prints a warning if the pad does not exist in the SKU selected with
+// the -sku option or detected from the dump, e.g. GPP_I0 for SPT-LP or GPP_E20
+// for SPT-H
+// pad : pad info
+func (parser *ParserData) padSkuCheck(pad *padInfo) {
+	if !config.IsSkuKnown() || pad.id == "" {
+		return
+	}
+	if valid, _, _ := parser.padLocate(pad.id); !valid {
+		fmt.Printf("Warning: pad %s does not exist in the %s SKU of the platform!\n",
+				pad.id, config.SkuGet())
+	}
+}
+
+// padNumbersSet - sets the numbers of the pad in the ACPI and Linux numbering
+// schemes using the community descriptors of the platform
+// pad : pad info
+func (parser *ParserData) padNumbersSet(pad *padInfo) {
+	pad.pin, pad.gpio = -1, -1
+	if pad.id == "" {
+		return
+	}
+	if pad.index < 0 {
+		valid, community, index := parser.padLocate(pad.id)
+		if !valid {
+			return
+		}
+		pad.community, pad.index = community, index
+	}
+	valid, community := parser.communityGet(pad.community)
+	if !valid {
+		return
+	}
+	if valid, pin := community.PinGet(pad.index); valid {
+		pad.pin = pin
+	}
+	if valid, gpio := community.GpioGet(pad.index); valid {
+		pad.gpio = gpio
+	}
+	pad.die, pad.dieCommunity = parser.dieCommunityGet(pad.community)
+}
+
+// numbersGet - returns the numbers of the pad for the -pin-numbers option: the
+// community-relative pad index used by ACPI, the Linux pinctrl pin number and
+// gpiochip offset
+func (info *padInfo) numbersGet() string {
+	str := fmt.Sprintf("community %d, pad %d", info.community, info.index)
+	if info.die != "" {
+		str = fmt.Sprintf("%s community %d, pad %d", strings.ToUpper(info.die),
+				info.dieCommunity, info.index)
+	}
+	if info.pin >= 0 {
+		str += fmt.Sprintf(", pin %d", info.pin)
+	}
+	if info.gpio >= 0 {
+		str += fmt.Sprintf(", gpio %d", info.gpio)
+	}
+	return str
+}
+
+// PinFprint - print all numbering schemes of the pad to the standard output
+// id : pad ID string
+// return error if the pad is not found in the community descriptors
+func (parser *ParserData) PinFprint(id string) error {
+	if config.IsPlatformLegacy() {
+		return fmt.Errorf("the pin numbers are not available for the platform")
+	}
+	parser.PlatformSpecificInterfaceSet()
+	if status, group := parser.platform.GroupNameExtract(id); status {
+		// the group may determine the PCH SKU
+		parser.groups = append(parser.groups, group)
+	}
+	pad := padInfo{id: id, community: -1, index: -1}
+	parser.padNumbersSet(&pad)
+	if pad.index < 0 {
+		return fmt.Errorf("pad %s was not found in the GPIO communities of the platform", id)
+	}
+	na := func(number int) string {
+		if number < 0 {
+			return "not exposed"
+		}
+		return strconv.Itoa(number)
+	}
+	fmt.Printf("%s:\n", id)
+	if pad.die != "" {
+		fmt.Printf("\tdie       : %s (GPIO controller)\n", strings.ToUpper(pad.die))
+	}
+	fmt.Printf("\tcommunity : %d\n", pad.dieCommunity)
+	fmt.Printf("\tpad       : %d (ACPI, community-relative pin index)\n", pad.index)
+	fmt.Printf("\tpin       : %s (Linux pinctrl pin number)\n", na(pad.pin))
+	fmt.Printf("\tgpio      : %s (Linux gpiochip offset)\n", na(pad.gpio))
+	return nil
+}
@@ -0,0 +1,242 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+import "../platforms/common"
+import "../config"
+
+// padField - decoded bit field of the pad configuration
+// name  : field name
+// value : the field value in the macro argument spelling
+type padField struct {
+	name  string
+	value string
+}
+
+// padDecode - generates the macro for the pad and decodes its bit fields
+// template : template type of the file from which the pad was extracted
+// return
+//     string of the macro
+//     list of the decoded bit fields
+func (parser *ParserData) padDecode(pad *padInfo, template int) (string, []padField) {
+	// Reset source remapping depends on the type of the input file
+	current := config.TemplateGet()
+	config.TemplateSet(template)
+	defer config.TemplateSet(current)
+
+	str := parser.platform.GenMacro(pad.id, pad.dw, pad.ownership, pad.lock, pad.interrupt,
+			config.InfoLevelGet())
+	macro := common.GetMacro()
+	dw0 := macro.Register(common.PAD_CFG_DW0)
+	dw1 := macro.Register(common.PAD_CFG_DW1)
+	dw2 := macro.Register(common.PAD_CFG_DW2)
+
+	route := []string{}
+	for _, irq := range []struct {
+		name   string
+		status uint8
+	}{
+		{"IOAPIC", dw0.GetGPIOInputRouteIOxAPIC()},
+		{"SCI", dw0.GetGPIOInputRouteSCI()},
+		{"SMI", dw0.GetGPIOInputRouteSMI()},
+		{"NMI", dw0.GetGPIOInputRouteNMI()},
+	} {
+		if irq.status != 0 {
+			route = append(route, irq.name)
+		}
+	}
+	if len(route) == 0 {
+		route = append(route, "NONE")
+	}
+
+	tol := "NONE"
+	if dw1.GetPadTol() != 0 {
+		tol = "1V8"
+	}
+
+	debounce := "NONE"
+	if macro.IsDebounceEnabled() {
+		debounce = macro.Field(macro.Debounce)
+	}
+
+	// The fields that are not used by the native function macros and the
+	// read-only fields of the platform are not compared
+	var fields []padField
+	for _, fld := range []struct {
+		padField
+		reg *common.Register
+		mask uint32
+		nf   bool
+	}{
+		{padField{"func", macro.Field(macro.Padfn)}, dw0, dw0.PadModeMaskGet(), true},
+		{padField{"reset", macro.Field(macro.Rstsrc)}, dw0, common.PadRstCfgMask, true},
+		{padField{"pull", macro.Field(macro.Pull)}, dw1, common.TermMask, true},
+		{padField{"trig", macro.Field(macro.Trig)}, dw0, common.RxLevelEdgeConfigurationMask, false},
+		{padField{"invert", macro.Field(macro.Invert)}, dw0, common.RxInvertMask, false},
+		{padField{"route", strings.Join(route, "|")}, dw0, common.InputRouteIOxApicMask |
+			common.InputRouteSCIMask | common.InputRouteSMIMask | common.InputRouteNMIMask, false},
+		{padField{"buf", macro.Field(macro.Bufdis)}, dw0, common.RxTxBufDisableMask, false},
+		{padField{"val", macro.Field(macro.Val)}, dw0, common.TxStateMask, false},
+		{padField{"own", macro.Field(macro.Own)}, nil, 0, false},
+		{padField{"iosstate", macro.Field(macro.IOSstate)}, dw1, common.IOStandbyStateMask, true},
+		{padField{"iosterm", macro.Field(macro.IOTerm)}, dw1, common.IOStandbyTerminationMask, true},
+		{padField{"tol", tol}, dw1, common.PadTolMask, true},
+		{padField{"debounce", debounce}, dw2, common.DebounceEnableMask | common.DebounceMask, false},
+	} {
+		if fld.reg != nil && fld.reg.ReadOnlyFieldsGet()&fld.mask == fld.mask {
+			continue
+		}
+		if fld.nf || dw0.GetPadMode() == 0 {
+			fields = append(fields, fld.padField)
+		}
+	}
+	return str, fields
+}
+
+// padChanges - compares the bit fields of two pads
+// return: list of changes, e.g. "pull UP_20K -> NONE"
+func padChanges(old []padField, new []padField) []string {
+	var changes []string
+	for _, newfld := range new {
+		for _, oldfld := range old {
+			if oldfld.name == newfld.name && oldfld.value != newfld.value {
+				changes = append(changes,
+					fmt.Sprintf("%s %s -> %s", oldfld.name, oldfld.value, newfld.value))
+			}
+		}
+	}
+	return changes
+}
+
+// padFind - returns the pad info with the corresponding ID or nil
+func (parser *ParserData) padFind(id string) *padInfo {
+	for i := range parser.padmap {
+		pad := &parser.padmap[i]
+		if pad.id == id && pad.isPad() {
+			return pad
+		}
+	}
+	return nil
+}
+
+// OverrideMapFprint - print to file the pads whose configuration differs from
+// the baseboard pad configuration table
+// base : parser data of the baseboard gpio.c
+// return
+//     number of the pads in the override table
+func (parser *ParserData) OverrideMapFprint(base *ParserData) int {
+	overrides := 0
+	for i := range parser.padmap {
+		pad := &parser.padmap[i]
+		if !pad.isPad() {
+			continue
+		}
+		if !pad.isHostOwned() {
+			if base.padFind(pad.id) != nil {
+				fmt.Printf("Warning: baseboard configures pad %s, which is owned by %s!\n",
+						pad.id, pad.ownerGet())
+			}
+			continue
+		}
+
+		var changes []string
+		_, fields := parser.padDecode(pad, config.TemplateGet())
+		if basepad := base.padFind(pad.id); basepad != nil {
+			_, basefields := base.padDecode(basepad, config.TempGpioh)
+			if changes = padChanges(basefields, fields); len(changes) == 0 {
+				continue
+			}
+		} else {
+			changes = append(changes, "not in the baseboard")
+		}
+
+		// Generate the macro again, since the singleton was used by the baseboard
+		str, _ := parser.padDecode(pad, config.TemplateGet())
+		pad.generate(0, "\n\t/* %s: %s */\n", pad.id, strings.Join(changes, ", "))
+		pad.padInfoMacroFprint(str)
+		overrides++
+	}
+
+	for _, basepad := range base.padmap {
+		if basepad.isPad() && parser.padFind(basepad.id) == nil {
+			fmt.Printf("Warning: baseboard pad %s was not found in the dump!\n", basepad.id)
+		}
+	}
+	return overrides
+}
+
+// bareMacro - returns the macro without the comments and the trailing comma
+// str : string of the generated macro
+func bareMacro(str string) string {
+	lines := strings.Split(str, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		// the information about the ignored fields follows the macro
+		if line := strings.TrimSpace(lines[i]); !strings.HasPrefix(line, "/*") {
+			return strings.TrimSuffix(line, ",")
+		}
+	}
+	return ""
+}
+
+// UpdateLines - updates the pad configuration macros in the lines of the coreboot
+// gpio.c, whose register meaning differs from the dump. Only the macro is replaced
+// in the line, comments, preprocessor conditionals and formatting are preserved.
+// Macros that span multiple lines are reported and not updated, the macros in
+// the comments are skipped.
+// lines : lines of the gpio.c file
+// return
+//     updated lines
+//     number of the updated macros
+func (parser *ParserData) UpdateLines(lines []string) ([]string, int) {
+	updated := make([]string, len(lines))
+	copy(updated, lines)
+	count := 0
+	incomment := false
+	for i, line := range lines {
+		var code string
+		code, incomment = commentsStrip(line, incomment)
+		name, args, pos, length := cbMacroFind(code)
+		if name == "" {
+			continue
+		}
+		if length < 0 {
+			fmt.Printf("line %d: Warning: %s continues on the next line and was not updated!\n",
+					i+1, name)
+			continue
+		}
+		id, dw, err := cbMacroDecode(name, args)
+		if err != nil {
+			fmt.Printf("line %d: %v\n", i+1, err)
+			continue
+		}
+		pad := parser.padFind(id)
+		if pad == nil {
+			fmt.Printf("line %d: pad %s was not found in the dump\n", i+1, id)
+			continue
+		}
+		if !pad.isHostOwned() {
+			fmt.Printf("line %d: Warning: pad %s is owned by %s and can not be configured!\n",
+					i+1, id, pad.ownerGet())
+			continue
+		}
+
+		current := padInfo{id: id, dw: dw, index: -1}
+		if dw[common.PAD_CFG_DW1]&padCfgOwnGpioDriver != 0 {
+			current.dw[common.PAD_CFG_DW1] &= ^padCfgOwnGpioDriver
+			current.ownership = 1
+		}
+		_, fields := parser.padDecode(&current, config.TempGpioh)
+		str, newfields := parser.padDecode(pad, config.TemplateGet())
+		changes := padChanges(fields, newfields)
+		if len(changes) == 0 {
+			continue
+		}
+		fmt.Printf("line %d: %s: %s\n", i+1, id, strings.Join(changes, ", "))
+		updated[i] = line[:pos] + bareMacro(str) + line[pos+length:]
+		count++
+	}
+	return updated, count
+}
@@ -7,15 +7,40 @@ import (
 	"strconv"
 )
 
+import "../platforms/common"
 import "../platforms/snr"
 import "../platforms/lbg"
 import "../platforms/apl"
+import "../platforms/block"
+import "../platforms/cnl"
+import "../platforms/tgl"
+import "../platforms/adl"
+import "../platforms/mtl"
+import "../platforms/glk"
+import "../platforms/dnv"
+import "../platforms/ebg"
+import "../platforms/ehl"
+import "../platforms/jsl"
 import "../config"
 
+// Pad owner from the PAD_OWN registers
+const (
+	PadOwnHost uint8 = 0
+	PadOwnCsme uint8 = 1
+	PadOwnIsh  uint8 = 2
+	// Not a PAD_OWN value: the pad of the Programmable Services Engine on Elkhart
+	// Lake, see pseOwnerGet()
+	PadOwnPse  uint8 = 0x10
+)
+
 // PlatformSpecific - platform-specific interface
 type PlatformSpecific interface {
-	GenMacro(id string, dw0 uint32, dw1 uint32, ownership uint8) string
+	GenMacro(id string, dw [common.MAX_DW_NUM]uint32, ownership uint8, lock uint8, interrupt uint8,
+			level uint8) string
 	GroupNameExtract(line string) (bool, string)
+	GpeGroupNameGet(value uint8) (bool, string)
+	CommunitiesGet(groups []string) []common.Community
+	NativeFunctionGet(id string, mode uint8) (bool, string)
 	KeywordCheck(line string) bool
 }
 
@@ -23,16 +48,34 @@ type PlatformSpecific interface {
 // id        : pad id string
 // offset    : the offset of the register address relative to the base
 // function  : the string that means the pad function
-// dw0       : DW0 register value
-// dw1       : DW1 register value
+// dw        : values of the pad configuration registers DW0, DW1, ...
 // ownership : host software ownership
+// lock      : pad configuration lock state
+// interrupt : driver-mode interrupt state
+// owner     : pad owner (host, CSME, ISH or PSE)
+// intsel    : Interrupt Select (INTSEL), IOxAPIC line of the pad
+// community : GPIO community number from the inteltool dump, -1 if unknown
+// index     : pad index within the community, -1 if unknown
+// pin       : Linux pinctrl pin number, -1 if unknown
+// gpio      : Linux gpiochip offset, -1 if unknown
+// die       : GPIO controller of the multi-die SoC, empty for a single controller
+// dieCommunity : community number within the GPIO controller of the die
 type padInfo struct {
 	id        string
 	offset    uint16
 	function  string
-	dw0       uint32
-	dw1       uint32
+	dw        [common.MAX_DW_NUM]uint32
 	ownership uint8
+	lock      uint8
+	interrupt uint8
+	owner     uint8
+	intsel    uint8
+	community int
+	index     int
+	pin       int
+	gpio      int
+	die       string
+	dieCommunity int
 }
 
 // generate - wrapper for Fprintf(). Writes text to the file specified
@@ -57,6 +100,40 @@ func (info *padInfo) reservedFprint() {
 	info.generate(0, "\t/* %s - %s */\n", info.id, info.function)
 }
 
+// isPad - returns true if the entry of the pad info map is a pad with the
+// configuration registers, not a community or group title and not a reserved pad.
+// The value 0 of DW0 is valid: GPIO mode with the PWROK reset.
+func (info *padInfo) isPad() bool {
+	return info.id != "" && info.dw[0] != 0xffffffff
+}
+
+// isHostOwned - returns true if the pad is owned by the host and can be configured
+// by the firmware
+func (info *padInfo) isHostOwned() bool {
+	return info.owner == PadOwnHost
+}
+
+// ownerGet - returns the name of the pad owner
+func (info *padInfo) ownerGet() string {
+	var owner = map[uint8]string{
+		PadOwnHost: "Host",
+		PadOwnCsme: "CSME",
+		PadOwnIsh:  "ISH",
+		PadOwnPse:  "PSE",
+	}
+	if str, valid := owner[info.owner]; valid {
+		return str
+	}
+	return "RESERVED"
+}
+
+// ownerFprint - print the pad that is not owned by the host to file as comment
+// /* GPP_D5 - ISH_I2C0_SDA: owned by ISH */
+func (info *padInfo) ownerFprint() {
+	info.generate(2, "\n")
+	info.generate(0, "\t/* %s - %s: owned by %s */\n", info.id, info.function, info.ownerGet())
+}
+
 // padInfoMacroFprint - print information about current pad to file using
 // special macros:
 // PAD_CFG_NF(GPP_F1, 20K_PU, PLTRST, NF1), /* SATAXPCIE4 */
@@ -65,11 +142,23 @@ func (info *padInfo) reservedFprint() {
 func (info *padInfo) padInfoMacroFprint(macro string) {
 	info.generate(2, "\n")
 	info.generate(1, "\t/* %s - %s ", info.id, info.function)
-	info.generate(2, "DW0: 0x%0.8x, DW1: 0x%0.8x ", info.dw0, info.dw1)
+	if info.index >= 0 && config.IsPinNumbersFlagUsed() {
+		info.generate(1, "(%s) ", info.numbersGet())
+	}
+	info.generate(2, "DW0: 0x%0.8x, DW1: 0x%0.8x ", info.dw[0], info.dw[1])
+	for number := common.PAD_CFG_DW2; number < common.MAX_DW_NUM; number++ {
+		if info.dw[number] != 0 {
+			info.generate(2, "DW%d: 0x%0.8x ", number, info.dw[number])
+		}
+	}
 	info.generate(1, "*/\n")
 	info.generate(0, "\t%s", macro)
 	if config.InfoLevelGet() == 0 {
-		info.generate(0, "\t/* %s */", info.function)
+		if info.index >= 0 && config.IsPinNumbersFlagUsed() {
+			info.generate(0, "\t/* %s (%s) */", info.function, info.numbersGet())
+		} else {
+			info.generate(0, "\t/* %s */", info.function)
+		}
 	}
 	info.generate(0, "\n")
 }
@@ -84,14 +173,52 @@ type ParserData struct {
 	line       string
 	padmap     []padInfo
 	ownership  map[string]uint32
+	lock       map[string]uint32
+	locktx     map[string]uint32
+	gpe0       []uint8
+	gpeen      map[string]uint32
+	gpests     map[string]uint32
+	gpiie      map[string]uint32
+	gpiis      map[string]uint32
+	padown     map[string]uint32
+	community  int
+	groups     []string
+	die        string
+	dieBase    int
+	legacy     map[string]uint32
+}
+
+// hostSwOwnKeyGet - returns the key of the HOSTSW_OWN register of the community
+// in the ownership map, e.g. N_0
+// number    : community number
+// community : community descriptor
+// register  : register number within the community
+func hostSwOwnKeyGet(number int, community common.Community, register int) string {
+	if community.Name == "" {
+		return strconv.Itoa(number) + "_" + strconv.Itoa(register)
+	}
+	return community.Name + "_" + strconv.Itoa(register)
 }
 
 // hostOwnershipGet - get the host software ownership value for the corresponding
 // pad ID
-// id : pad ID string
+// id     : pad ID string
+// offset : offset of the pad configuration registers in the current community
 // return the host software ownership form the parser struct
-func (parser *ParserData) hostOwnershipGet(id string) uint8 {
+func (parser *ParserData) hostOwnershipGet(id string, offset uint16) uint8 {
 	var ownership uint8 = 0
+	if valid, community := parser.communityGet(parser.community); valid && community.HostSwOwn != 0 {
+		// HOSTSW_OWN registers of the community, 32 pads per register
+		if valid, index := community.PadIndexGet(offset); valid &&
+				config.TemplateGet() == config.TempInteltool {
+			register, bit := community.HostSwOwnBitGet(index)
+			name := hostSwOwnKeyGet(parser.community, community, register)
+			if (parser.ownership[name] & (1 << uint8(bit))) != 0 {
+				ownership = 1
+			}
+		}
+		return ownership
+	}
 	status, group := parser.platform.GroupNameExtract(id)
 	if config.TemplateGet() == config.TempInteltool && status {
 		numder, _ := strconv.Atoi(strings.TrimLeft(id, group))
@@ -102,22 +229,112 @@ func (parser *ParserData) hostOwnershipGet(id string) uint8 {
 	return ownership
 }
 
+// padRegisterBitGet - get the bit of the group register for the corresponding pad ID
+// registers : map of the group registers
+// id        : pad ID string
+// return
+//     valid : true if the dump contains the register for the pad group
+//     set   : true if the bit of the pad is set
+func (parser *ParserData) padRegisterBitGet(registers map[string]uint32, id string) (
+		valid bool, set bool) {
+	status, group := parser.platform.GroupNameExtract(id)
+	if config.TemplateGet() != config.TempInteltool || !status {
+		return false, false
+	}
+	value, valid := registers[group]
+	numder, _ := strconv.Atoi(strings.TrimLeft(id, group))
+	return valid, (value & (1 << uint8(numder))) != 0
+}
+
+// padOwnerGet - get the pad owner for the corresponding pad ID. Each PAD_OWN register
+// contains the owner of 8 pads of the group in 4-bit fields
+// id : pad ID string
+// return the owner from the PAD_OWN registers or PadOwnHost if the dump does not
+// contain them for the pad group
+func (parser *ParserData) padOwnerGet(id string) uint8 {
+	status, group := parser.platform.GroupNameExtract(id)
+	if config.TemplateGet() != config.TempInteltool || !status {
+		return PadOwnHost
+	}
+	numder, _ := strconv.Atoi(strings.TrimLeft(id, group))
+	value, valid := parser.padown[group+"_"+strconv.Itoa(numder/8)]
+	if !valid {
+		return PadOwnHost
+	}
+	return uint8((value >> (4 * uint8(numder%8))) & 0x3)
+}
+
+// padLockGet - get the pad configuration lock state for the corresponding pad ID
+// id : pad ID string
+// return the lock state from the PADCFGLOCK and PADCFGLOCKTX registers or
+// common.PAD_LOCK_UNKNOWN if the dump does not contain them for the pad group
+func (parser *ParserData) padLockGet(id string) uint8 {
+	valid, lock := parser.padRegisterBitGet(parser.lock, id)
+	validtx, locktx := parser.padRegisterBitGet(parser.locktx, id)
+	if !valid && !validtx {
+		return common.PAD_LOCK_UNKNOWN
+	}
+	var state uint8 = common.PAD_UNLOCK
+	if lock {
+		state |= common.PAD_LOCK_CONFIG
+	}
+	if locktx {
+		state |= common.PAD_LOCK_TX
+	}
+	return state
+}
+
+// padInterruptGet - get the driver-mode interrupt state for the corresponding pad ID
+// id : pad ID string
+// return the state from the GPI_IE register or common.PAD_INT_UNKNOWN if the dump
+// does not contain it for the pad group
+func (parser *ParserData) padInterruptGet(id string) uint8 {
+	valid, enabled := parser.padRegisterBitGet(parser.gpiie, id)
+	if !valid {
+		return common.PAD_INT_UNKNOWN
+	}
+	if enabled {
+		return common.PAD_INT_ENABLE
+	}
+	return common.PAD_INT_DISABLE
+}
+
 // padInfoExtract - adds a new entry to pad info map
 // return error status
 func (parser *ParserData) padInfoExtract() int {
 	var function, id string
-	var dw0, dw1 uint32
+	var dw [common.MAX_DW_NUM]uint32
+	var offset uint16
 	var template = map[int]template{
 		config.TempInteltool: useInteltoolLogTemplate,
 		config.TempGpioh    : useGpioHTemplate,
 		config.TempSpec     : useYourTemplate,
 	}
-	if template[config.TemplateGet()](parser.line, &function, &id, &dw0, &dw1) == 0 {
+	if template[config.TemplateGet()](parser.line, &function, &id, &offset, &dw) == 0 {
+		var intsel uint8 = 0
+		if config.TemplateGet() == config.TempInteltool {
+			// Interrupt Select (INTSEL) is read-only, keep it only as information
+			reg := common.Register{}
+			intsel = reg.ValueSet(dw[common.PAD_CFG_DW1]).GetInterruptSelect()
+			dw[common.PAD_CFG_DW1] &= ^common.InterruptSelectMask
+		}
+		ownership := parser.hostOwnershipGet(id, offset)
+		if config.TemplateGet() == config.TempGpioh && dw[common.PAD_CFG_DW1]&padCfgOwnGpioDriver != 0 {
+			// PAD_CFG_OWN_GPIO(DRIVER) from the coreboot macro
+			ownership = 1
+			dw[common.PAD_CFG_DW1] &= ^padCfgOwnGpioDriver
+		}
 		pad := padInfo{id: id,
+			offset: offset,
 			function: function,
-			dw0: dw0,
-			dw1: dw1,
-			ownership: parser.hostOwnershipGet(id)}
+			dw: dw,
+			ownership: ownership,
+			lock: parser.padLockGet(id),
+			interrupt: parser.padInterruptGet(id),
+			owner: pseOwnerGet(parser.padOwnerGet(id), function),
+			intsel: intsel,
+			community: parser.community,
+			index: -1}
 		parser.padmap = append(parser.padmap, pad)
 		return 0
 	}
@@ -125,9 +342,30 @@ func (parser *ParserData) padInfoExtract() int {
 	return -1
 }
 
+// communityGet - returns the descriptor of the GPIO community
+// number : community number from the inteltool dump
+func (parser *ParserData) communityGet(number int) (bool, common.Community) {
+	communities := parser.platform.CommunitiesGet(parser.groups)
+	if number < 0 || number >= len(communities) {
+		return false, common.Community{}
+	}
+	return true, communities[number]
+}
+
 // communityGroupExtract
+// GPIO Community 0
+// GPIO Group GPP_A
 func (parser *ParserData) communityGroupExtract() {
-	pad := padInfo{function: parser.line}
+	var community int
+	if n, _ := fmt.Sscanf(strings.TrimSpace(parser.line), "GPIO Community %d", &community); n == 1 {
+		parser.community = parser.dieBase + community
+	} else if strings.Contains(parser.line, "GPIO Community") {
+		// the communities without numbers follow in the order of the descriptors
+		parser.community++
+	} else if status, group := parser.platform.GroupNameExtract(parser.line); status {
+		parser.groups = append(parser.groups, group)
+	}
+	pad := padInfo{function: parser.line, community: parser.community, index: -1}
 	parser.padmap = append(parser.padmap, pad)
 }
 
@@ -141,6 +379,28 @@ func (parser *ParserData) PlatformSpecificInterfaceSet() {
 			InheritanceTemplate : snr.PlatformSpecific{},
 		},
 		config.ApolloType    : apl.PlatformSpecific{},
+		config.CannonType    : cnl.PlatformSpecific{},
+		// See platforms/block/macro.go
+		config.TigerType     : block.PlatformSpecific{
+			InheritanceTemplate : tgl.PlatformSpecific{},
+		},
+		config.AlderType     : block.PlatformSpecific{
+			InheritanceTemplate : adl.PlatformSpecific{},
+		},
+		config.MeteorType    : block.PlatformSpecific{
+			InheritanceTemplate : mtl.PlatformSpecific{},
+		},
+		// See platforms/glk/macro.go
+		config.GeminiType    : glk.PlatformSpecific{},
+		config.DenvertonType : dnv.PlatformSpecific{},
+		// See platforms/ebg/macro.go
+		config.EmmitsType    : ebg.PlatformSpecific{},
+		config.ElkhartType   : block.PlatformSpecific{
+			InheritanceTemplate : ehl.PlatformSpecific{},
+		},
+		config.JasperType    : block.PlatformSpecific{
+			InheritanceTemplate : jsl.PlatformSpecific{},
+		},
 	}
 	parser.platform = platform[config.PlatformGet()]
 }
@@ -148,16 +408,81 @@ func (parser *ParserData) PlatformSpecificInterfaceSet() {
 // PadMapFprint - print pad info map to file
 func (parser *ParserData) PadMapFprint() {
 	for _, pad := range parser.padmap {
-		switch pad.dw0 {
-		case 0:
+		switch {
+		case pad.id == "":
 			pad.titleFprint()
-		case 0xffffffff:
+		case !pad.isPad():
 			pad.reservedFprint()
 		default:
-			str := parser.platform.GenMacro(pad.id, pad.dw0, pad.dw1, pad.ownership)
+			if !pad.isHostOwned() {
+				pad.ownerFprint()
+				break
+			}
+			str := parser.platform.GenMacro(pad.id, pad.dw, pad.ownership, pad.lock, pad.interrupt,
+					config.InfoLevelGet())
+			pad.padInfoMacroFprint(str)
+		}
+	}
+}
+
+// PadListFprint - print only the pads from the list to file
+// ids : list of pad ID strings
+// return
+//     list of pad IDs that were not found in the pad info map
+func (parser *ParserData) PadListFprint(ids []string) []string {
+	var missing []string
+	for _, id := range ids {
+		found := false
+		for _, pad := range parser.padmap {
+			if pad.id != id || !pad.isPad() {
+				continue
+			}
+			found = true
+			if !pad.isHostOwned() {
+				fmt.Printf("Warning: pad %s is owned by %s and can not be configured!\n",
+						pad.id, pad.ownerGet())
+				pad.ownerFprint()
+				break
+			}
+			str := parser.platform.GenMacro(pad.id, pad.dw, pad.ownership, pad.lock, pad.interrupt,
+					config.InfoLevelGet())
 			pad.padInfoMacroFprint(str)
+			break
+		}
+		if !found {
+			missing = append(missing, id)
 		}
 	}
+	return missing
+}
+
+// LockMapFprint - print to file the table with the pad configuration lock state
+// for gpio_lock_pads(). The table is not generated if no pad is locked.
+// return
+//     true if the table was generated
+func (parser *ParserData) LockMapFprint() bool {
+	var action = map[uint8]string{
+		common.PAD_LOCK_CONFIG: "GPIO_LOCK_CONFIG",
+		common.PAD_LOCK_TX:     "GPIO_LOCK_TX",
+		common.PAD_LOCK_FULL:   "GPIO_LOCK_FULL",
+	}
+	header := false
+	for _, pad := range parser.padmap {
+		str, valid := action[pad.lock]
+		if !valid || !pad.isPad() || !pad.isHostOwned() {
+			continue
+		}
+		if !header {
+			pad.generate(0, "\n/* Pad configuration lock */\n")
+			pad.generate(0, "static const struct gpio_lock_config pad_lock_config[] = {\n")
+			header = true
+		}
+		pad.generate(0, "\t{ %s, %s },\n", pad.id, str)
+	}
+	if header {
+		fmt.Fprint(config.OutputGenFile, "};\n")
+	}
+	return header
 }
 
 // Register - read specific platform registers (32 bits)
@@ -185,6 +510,16 @@ func (parser *ParserData) Register(nameTemplate string) (
 //                       return true if success
 func (parser *ParserData) padOwnershipExtract() bool {
 	var group string
+	if valid, community := parser.communityGet(parser.community); valid && community.HostSwOwn != 0 {
+		// 0x0084: 0x00000000 (HOSTSW_OWN_1)
+		status, _, offset, value := parser.Register("HOSTSW_OWN")
+		if status && offset >= uint32(community.HostSwOwn) {
+			group = hostSwOwnKeyGet(parser.community, community,
+					int(offset - uint32(community.HostSwOwn)) / 4)
+			parser.ownership[group] = value
+		}
+		return status
+	}
 	status, name, offset, value := parser.Register("HOSTSW_OWN_GPP_")
 	if status {
 		_, group = parser.platform.GroupNameExtract(parser.line)
@@ -195,14 +530,67 @@ func (parser *ParserData) padOwnershipExtract() bool {
 	return status
 }
 
+// padOwnerExtract - extract Pad Ownership from inteltool dump, return true if success
+// 0x0020: 0x00000000 (PAD_OWN_GPP_A_0)
+func (parser *ParserData) padOwnerExtract() bool {
+	status, name, _, value := parser.Register("PAD_OWN_GPP_")
+	if status {
+		parser.padown[strings.TrimPrefix(name, "PAD_OWN_")] = value
+	}
+	return status
+}
+
+// padLockExtract - extract Pad Configuration Lock and Pad Configuration Lock Tx
+//                  from inteltool dump, return true if success
+func (parser *ParserData) padLockExtract() bool {
+	for _, register := range []struct {
+		name string
+		lock map[string]uint32
+	}{
+		{"PADCFGLOCK_GPP_", parser.lock},
+		{"PADCFGLOCKTX_GPP_", parser.locktx},
+	} {
+		if status, _, _, value := parser.Register(register.name); status {
+			_, group := parser.platform.GroupNameExtract(parser.line)
+			register.lock[group] = value
+			return true
+		}
+	}
+	return false
+}
+
+// padInterruptExtract - extract GPI Interrupt Enable and GPI Interrupt Status from
+//                       inteltool dump, return true if success
+func (parser *ParserData) padInterruptExtract() bool {
+	for _, register := range []struct {
+		name string
+		gpi  map[string]uint32
+	}{
+		{"GPI_IE_GPP_", parser.gpiie},
+		{"GPI_IS_GPP_", parser.gpiis},
+	} {
+		if status, _, _, value := parser.Register(register.name); status {
+			_, group := parser.platform.GroupNameExtract(parser.line)
+			register.gpi[group] = value
+			return true
+		}
+	}
+	return false
+}
+
 // padConfigurationExtract - reads GPIO configuration registers and returns true if the
 //                           information from the inteltool log was successfully parsed.
 func (parser *ParserData) padConfigurationExtract() bool {
-	// Only for Sunrise PCH and only for inteltool.log file template
-	if config.TemplateGet() != config.TempInteltool || config.IsPlatformApollo() {
+	// Only for inteltool.log file template, Apollo Lake and Gemini Lake have only
+	// the ownership registers and MISCCFG in the communities
+	if config.TemplateGet() != config.TempInteltool {
 		return false
 	}
-	return parser.padOwnershipExtract()
+	if config.IsPlatformApollo() || config.IsPlatformGemini() {
+		return parser.padOwnershipExtract() || parser.gpeExtract()
+	}
+	return parser.padOwnershipExtract() || parser.padOwnerExtract() ||
+			parser.padLockExtract() || parser.gpeExtract() || parser.padInterruptExtract()
 }
 
 // Parse pads groupe information in the inteltool log file
@@ -217,10 +605,36 @@ func (parser *ParserData) Parse() {
 	// map of thepad ownership registers for the GPIO controller
 	parser.ownership = make(map[string]uint32)
 
+	// map of the pad owner registers
+	parser.padown = make(map[string]uint32)
+
+	// maps of the pad configuration lock registers
+	parser.lock = make(map[string]uint32)
+	parser.locktx = make(map[string]uint32)
+
+	// maps of the GPE registers
+	parser.gpeen = make(map[string]uint32)
+	parser.gpests = make(map[string]uint32)
+
+	// maps of the GPI interrupt registers
+	parser.gpiie = make(map[string]uint32)
+	parser.gpiis = make(map[string]uint32)
+
+	// the community number is unknown until the first community header
+	parser.community = -1
+
+	if config.IsPlatformLegacy() {
+		// the platforms without the pad configuration registers, see legacy.go
+		parser.legacyParse()
+		return
+	}
+
 	scanner := bufio.NewScanner(config.InputRegDumpFile)
 	for scanner.Scan() {
-		parser.line = scanner.Text()
-		if strings.Contains(parser.line, "GPIO Community") || strings.Contains(parser.line, "GPIO Group") {
+		parser.line = parser.dieLineGet(scanner.Text())
+		if strings.Contains(parser.line, "GPIO Controller") {
+			parser.dieExtract()
+		} else if strings.Contains(parser.line, "GPIO Community") || strings.Contains(parser.line, "GPIO Group") {
 			parser.communityGroupExtract()
 		} else if !parser.padConfigurationExtract() && parser.platform.KeywordCheck(parser.line) {
 			if parser.padInfoExtract() != 0 {
@@ -228,5 +642,20 @@ func (parser *ParserData) Parse() {
 			}
 		}
 	}
+	if config.IsPlatformSunrise() && !config.IsSkuSet() && len(parser.groups) != 0 {
+		// the FSP pad prefix depends on the SKU, see fields/fsp/fsp.go
+		config.SkuDetectedSet(snr.SkuDetect(parser.groups))
+	} else if config.IsPlatformCannon() && !config.IsSkuSet() && len(parser.groups) != 0 {
+		// the GPE0 groups of MISCCFG depend on the SKU, see platforms/cnl/template.go
+		config.SkuDetectedSet(cnl.SkuDetect(parser.groups))
+	}
+	if config.TemplateGet() == config.TempInteltool {
+		parser.padIndexCheck()
+	}
+	for i := range parser.padmap {
+		parser.padSkuCheck(&parser.padmap[i])
+		parser.padNumbersSet(&parser.padmap[i])
+		parser.padFunctionCheck(&parser.padmap[i])
+	}
 	fmt.Println("...done!")
 }
@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+)
+
+import "../config"
+
+// PSE devices that can be assigned to the Programmable Services Engine and
+// the names of their ownership members in the chip config of Elkhart Lake, see
+// struct soc_intel_elkhartlake_config in src/soc/intel/elkhartlake/chip.h
+var pseDevices = map[string]string{
+	"UART":   "PseUartOwn",
+	"HSUART": "PseHsuartOwn",
+	"I2C":    "PseI2cOwn",
+	"I2S":    "PseI2sOwn",
+	"SPI":    "PseSpiOwn",
+	"GBE":    "PseGbeOwn",
+	"CAN":    "PseCanOwn",
+	"QEP":    "PseQepOwn",
+}
+
+// Value of enum pse_device_ownership {Device_Disabled, PSE_Owned, Host_Owned}
+// from chip.h for the device owned by PSE
+const PseOwned = "PSE_Owned"
+
+var pseFunction = regexp.MustCompile(`^PSE_(UART|HSUART|I2C|I2S|SPI|GBE|CAN|QEP)([0-9]+)_`)
+
+// pseDeviceGet - returns the devicetree setting of the PSE device that uses the
+// native function of the pad
+// function : native function name from the dump, e.g. PSE_I2C2_SCL
+// return
+//     bool   : true if the function belongs to the PSE device
+//     string : devicetree setting with the device number, e.g. PseI2cOwn[2]
+func pseDeviceGet(function string) (bool, string) {
+	match := pseFunction.FindStringSubmatch(function)
+	if match == nil {
+		return false, ""
+	}
+	return true, fmt.Sprintf("%s[%s]", pseDevices[match[1]], match[2])
+}
+
+// pseOwnerGet - returns the owner of the pad on Elkhart Lake. The PAD_OWN
+// registers do not distinguish PSE from ISH, so the pad owned by ISH belongs to
+// PSE if its native function in the dump is a function of the PSE device
+// owner    : owner from the PAD_OWN registers
+// function : native function name from the dump, e.g. PSE_I2C2_SCL
+// return: PadOwnPse or the owner from the registers
+func pseOwnerGet(owner uint8, function string) uint8 {
+	if config.IsPlatformElkhart() && owner == PadOwnIsh && pseFunction.MatchString(function) {
+		return PadOwnPse
+	}
+	return owner
+}
+
+// PseFprint - print to file the list of the pads owned by PSE and the devicetree
+// settings for the ownership of the PSE devices that use them. The pads are
+// not configured by coreboot, so they are reported separately from the GPIO
+// table. Only for Elkhart Lake.
+func (parser *ParserData) PseFprint() {
+	if !config.IsPlatformElkhart() {
+		return
+	}
+	var pads, devices []string
+	owned := make(map[string]bool)
+	for _, pad := range parser.padmap {
+		if !pad.isPad() || pad.owner != PadOwnPse {
+			continue
+		}
+		pads = append(pads, pad.id + " - " + pad.function)
+		if valid, device := pseDeviceGet(pad.function); valid && !owned[device] {
+			owned[device] = true
+			devices = append(devices, device)
+		}
+	}
+	if len(pads) == 0 {
+		return
+	}
+
+	config.OutputGenFile.WriteString("\n/*\n * Pads owned by PSE:\n")
+	for _, str := range pads {
+		fmt.Fprintf(config.OutputGenFile, " *\t%s\n", str)
+	}
+	if len(devices) != 0 {
+		config.OutputGenFile.WriteString(" *\n * PSE device ownership for devicetree.cb:\n")
+		for _, device := range devices {
+			fmt.Fprintf(config.OutputGenFile, " *\tregister \"%s\" = \"%s\"\n", device, PseOwned)
+		}
+	}
+	config.OutputGenFile.WriteString(" */\n")
+}
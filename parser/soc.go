@@ -0,0 +1,145 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+import "../platforms/common"
+import "../platforms/byt"
+import "../platforms/bsw"
+import "../config"
+
+// SocSpecific - interface of the SoC with the PCONF0/PAD_VAL pad registers and
+// a table of struct soc_gpio_map for each GPIO community
+type SocSpecific interface {
+	GenMacro(community string, index int, dw [common.MAX_DW_NUM]uint32) string
+	TablesGet() []common.SocTable
+}
+
+// socSpecificGet - returns the interface for the SoC selected in the configuration
+func socSpecificGet() SocSpecific {
+	if config.IsPlatformBraswell() {
+		return bsw.PlatformSpecific{}
+	}
+	return byt.PlatformSpecific{}
+}
+
+// socCommunityExtract - returns the number of the table for the community header
+// GPIO Community SCORE
+// GPIO Community 0
+func (parser *ParserData) socCommunityExtract(tables []common.SocTable) int {
+	var number int
+	name := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(parser.line), "GPIO Community"))
+	if n, _ := fmt.Sscanf(name, "%d", &number); n == 1 && number >= 0 && number < len(tables) {
+		return number
+	}
+	for i, table := range tables {
+		if strings.EqualFold(table.Community, name) {
+			return i
+		}
+	}
+	fmt.Printf("Warning: unknown GPIO community %s, the pads are skipped!\n", name)
+	return -1
+}
+
+// socParse - read the pad registers of the communities of Bay Trail or Braswell
+// from the inteltool log file
+// GPIO Community SCORE
+// 0x0000: 0x2003cc81 0x04c00000 0x00000006 GPIO_S0_SC000 SATA_GP0
+func (parser *ParserData) socParse() {
+	tables := socSpecificGet().TablesGet()
+	counts := make([]int, len(tables))
+	scanner := bufio.NewScanner(config.InputRegDumpFile)
+	for scanner.Scan() {
+		parser.line = scanner.Text()
+		if strings.Contains(parser.line, "GPIO Community") {
+			parser.community = parser.socCommunityExtract(tables)
+			if parser.community >= 0 {
+				pad := padInfo{function: tables[parser.community].Community + " GPIOs",
+						community: parser.community, index: -1}
+				parser.padmap = append(parser.padmap, pad)
+			}
+			continue
+		}
+		if parser.community < 0 || !strings.HasPrefix(strings.TrimSpace(parser.line), "0x") {
+			continue
+		}
+		var function, id string
+		var offset uint16
+		var dw [common.MAX_DW_NUM]uint32
+		if useInteltoolLogTemplate(parser.line, &function, &id, &offset, &dw) != 0 || id == "" {
+			continue
+		}
+		pad := padInfo{id: id,
+			offset: offset,
+			function: function,
+			dw: dw,
+			community: parser.community,
+			index: counts[parser.community]}
+		parser.padmap = append(parser.padmap, pad)
+		counts[parser.community]++
+	}
+	for i, table := range tables {
+		if table.Size != 0 && counts[i] != 0 && counts[i] != table.Size {
+			fmt.Printf("Warning: the community %s contains %d pads instead of %d!\n",
+					table.Community, counts[i], table.Size)
+		}
+	}
+	fmt.Println("...done!")
+}
+
+// socMapFprint - print to file gpio.c with the tables of struct soc_gpio_map for
+// each community from the dump and struct soc_gpio_config for
+// mainboard_get_gpios()
+func (parser *ParserData) socMapFprint() {
+	platform := socSpecificGet()
+	tables := platform.TablesGet()
+	config.OutputGenFile.WriteString(`/* SPDX-License-Identifier: GPL-2.0-only */
+
+#include <soc/gpio.h>
+
+/* Pad configuration was generated automatically using intelp2m utility */
+`)
+	var used []common.SocTable
+	for i, pad := range parser.padmap {
+		if pad.id == "" {
+			if i != 0 {
+				config.OutputGenFile.WriteString("\tGPIO_END\n};\n")
+			}
+			table := tables[pad.community]
+			fmt.Fprintf(config.OutputGenFile, "\n/* %s */\n", pad.function)
+			fmt.Fprintf(config.OutputGenFile, "static const struct soc_gpio_map %s[] = {\n",
+					table.Name)
+			used = append(used, table)
+			continue
+		}
+		// the table is indexed by the pad number, so the comment contains the pad ID
+		info := pad
+		info.index = -1
+		if config.InfoLevelGet() == 0 {
+			info.function = pad.id + " - " + pad.function
+		}
+		str := platform.GenMacro(tables[pad.community].Community, pad.index, pad.dw)
+		info.padInfoMacroFprint(str)
+	}
+	if len(used) == 0 {
+		fmt.Println("Warning: the dump does not contain the GPIO communities of the SoC!")
+		return
+	}
+	config.OutputGenFile.WriteString("\tGPIO_END\n};\n")
+
+	// mainboard_get_gpios() returns the non-const pointer, see soc/gpio.h
+	config.OutputGenFile.WriteString("\nstatic struct soc_gpio_config gpio_config = {\n")
+	for _, table := range used {
+		fmt.Fprintf(config.OutputGenFile, "\t.%s = %s,\n", table.Member, table.Name)
+	}
+	config.OutputGenFile.WriteString(`};
+
+struct soc_gpio_config *mainboard_get_gpios(void)
+{
+	return &gpio_config;
+}
+`)
+}
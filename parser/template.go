@@ -6,7 +6,9 @@ import (
 	"unicode"
 )
 
-type template func(string, *string, *string, *uint32, *uint32) int
+import "../platforms/common"
+
+type template func(string, *string, *string, *uint16, *[common.MAX_DW_NUM]uint32) int
 
 // extractPadFuncFromComment
 // line   : string from file with pad config map
@@ -34,30 +36,39 @@ func tokenCheck(c rune) bool {
 // line      : string from file with pad config map
 // *function : the string that means the pad function
 // *id       : pad id string
-// *dw0      : DW0 register value
-// *dw1      : DW1 register value
+// *offset   : offset of the pad configuration registers
+// *dw       : values of the pad configuration registers
 // return
 //   error status
 func useInteltoolLogTemplate(line string, function *string,
-	id *string, dw0 *uint32, dw1 *uint32) int {
+	id *string, offset *uint16, dw *[common.MAX_DW_NUM]uint32) int {
 
-	var val uint64
 	// 0x0520: 0x0000003c44000600 GPP_B12  SLP_S0#
 	// 0x0438: 0xffffffffffffffff GPP_C7   RESERVED
-	if fields := strings.FieldsFunc(line, tokenCheck); len(fields) >= 4 {
-		fmt.Sscanf(fields[1], "0x%x", &val)
-		*dw0 = uint32(val & 0xffffffff)
-		*dw1 = uint32(val >> 32)
-		*id = fields[2]
-		*function = fields[3]
+	// The platforms with more configuration registers per pad have extra columns:
+	// 0x0600: 0x0000002044000300 0x00000000 GPP_A0 ESPI_IO0
+	fields := strings.FieldsFunc(line, tokenCheck)
+	number, i := 0, 1
+	for ; i < len(fields) && strings.HasPrefix(fields[i], "0x"); i++ {
+		var val uint64
+		fmt.Sscanf(fields[i], "0x%x", &val)
+		// 64-bit column contains two registers, the low DWord goes first
+		for dwords := (len(fields[i]) - 2 + 7) / 8; dwords > 0 && number < len(dw); dwords-- {
+			dw[number] = uint32(val & 0xffffffff)
+			val >>= 32
+			number++
+		}
+	}
+	if number != 0 && len(fields) >= i+2 {
+		fmt.Sscanf(fields[0], "0x%x", offset)
+		*id = fields[i]
+		*function = fields[i+1]
 		// Sometimes the configuration file contains compound functions such as
 		// SUSWARN#/SUSPWRDNACK. Since the template does not take this into account,
 		// need to collect all parts of the pad function back into a single word
-		for i := 4; i < len(fields); i++ {
+		for i += 2; i < len(fields); i++ {
 			*function += "/" + fields[i]
 		}
-		// clear RO Interrupt Select (INTSEL)
-		*dw1 &= 0xffffff00
 	}
 	return 0
 }
@@ -66,12 +77,12 @@ func useInteltoolLogTemplate(line string, function *string,
 // line      : string from file with pad config map
 // *function : the string that means the pad function
 // *id       : pad id string
-// *dw0      : DW0 register value
-// *dw1      : DW1 register value
+// *offset   : offset of the pad configuration registers
+// *dw       : values of the pad configuration registers
 // return
 //   error status
 func useGpioHTemplate(line string, function *string,
-	id *string, dw0 *uint32, dw1 *uint32) int {
+	id *string, offset *uint16, dw *[common.MAX_DW_NUM]uint32) int {
 
 	// /* RCIN# */	_PAD_CFG_STRUCT(GPP_A0, 0x44000702, 0x00000000),
 	// _PAD_CFG_STRUCT(GPP_A0, 0x44000702, 0x00000000), /* RCIN# */
@@ -86,27 +97,40 @@ func useGpioHTemplate(line string, function *string,
 
 			if !strings.Contains(fields[i+2], "0x") || !strings.Contains(fields[i+3], "0x") {
 				/* definitions inside the macro do not match the pattern */
-				return -1
+				break
 			}
 			*id = fields[i+1]
-			fmt.Sscanf(fields[i+2], "0x%x", dw0)
-			fmt.Sscanf(fields[i+3], "0x%x", dw1)
+			fmt.Sscanf(fields[i+2], "0x%x", &dw[common.PAD_CFG_DW0])
+			fmt.Sscanf(fields[i+3], "0x%x", &dw[common.PAD_CFG_DW1])
 			*function = extractPadFuncFromComment(line)
 			return 0
 		}
 	}
+
+	// PAD_CFG_NF(GPP_A1, UP_20K, DEEP, NF1),	/* LAD0 */
+	// _PAD_CFG_STRUCT(GPP_A1, PAD_FUNC(NF1) | PAD_RESET(DEEP), PAD_PULL(UP_20K)),
+	// _PAD_CFG_STRUCT_3(GPP_A2, 0x44000702, 0x00000000, 0x00000007),
+	if name, args, _, length := cbMacroFind(line); name != "" && length >= 0 {
+		var err error
+		if *id, *dw, err = cbMacroDecode(name, args); err != nil {
+			fmt.Printf("%s\n\t%v\n", strings.TrimSpace(line), err)
+			return -1
+		}
+		*function = extractPadFuncFromComment(line)
+		return 0
+	}
 	return -1
 }
 
 // useYourTemplate
 func useYourTemplate(line string, function *string,
-	id *string, dw0 *uint32, dw1 *uint32) int {
+	id *string, offset *uint16, dw *[common.MAX_DW_NUM]uint32) int {
 
 	// ADD YOUR TEMPLATE HERE
 	*function = ""
 	*id = ""
-	*dw0 = 0
-	*dw1 = 0
+	*offset = 0
+	*dw = [common.MAX_DW_NUM]uint32{}
 
 	fmt.Printf("ADD YOUR TEMPLATE!\n")
 	return -1
@@ -122,7 +146,12 @@ func useYourTemplate(line string, function *string,
 func registerInfoTemplate(line string, name *string, offset *uint32, value *uint32) int {
 	// 0x0088: 0x00ffffff (HOSTSW_OWN_GPP_F)
 	// 0x0100: 0x00000000 (GPI_IS_GPP_A)
-	if fields := strings.FieldsFunc(line, tokenCheck); len(fields) == 3 {
+	// gpiobase+0x0000: 0x1f7ff7fd (GPIO_USE_SEL)
+	fields := strings.FieldsFunc(line, tokenCheck)
+	if len(fields) == 4 && fields[0] == "gpiobase" {
+		fields = fields[1:]
+	}
+	if len(fields) == 3 {
 			*name = fields[2]
 			fmt.Sscanf(fields[1], "0x%x", value)
 			fmt.Sscanf(fields[0], "0x%x", offset)
@@ -0,0 +1,23 @@
+package main
+
+import "fmt"
+
+import "./parser"
+
+// printPinNumbers - print the community-relative pad index used by ACPI, the Linux
+// pinctrl pin number and gpiochip offset for each pad
+// intelp2m -p snr pin GPP_C6
+// ids : pad ID strings
+// return: error if any of the pads is not found
+func printPinNumbers(ids []string) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("no pad is specified, e.g. intelp2m pin GPP_C6")
+	}
+	for _, id := range ids {
+		parser := parser.ParserData{}
+		if err := parser.PinFprint(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
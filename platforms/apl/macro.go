@@ -313,17 +313,17 @@ func (PlatformSpecific) NoConnMacroAdd() {
 }
 
 // GenMacro - generate pad macro
-// dw0 : DW0 config register value
-// dw1 : DW1 config register value
+// dw : values of the pad configuration registers
+// lock : pad configuration lock state
+// interrupt : driver-mode interrupt state
+// level : info level of the comments in the macro
 // return: string of macro
 //         error
-func (PlatformSpecific) GenMacro(id string, dw0 uint32, dw1 uint32, ownership uint8) string {
+func (PlatformSpecific) GenMacro(id string, dw [MAX_DW_NUM]uint32, ownership uint8, lock uint8, interrupt uint8, level uint8) string {
 	macro := common.GetInstanceMacro(PlatformSpecific{}, fields.InterfaceGet())
 	// use platform-specific interface in Macro struct
-	macro.PadIdSet(id).SetPadOwnership(ownership)
-	macro.Register(PAD_CFG_DW0).CntrMaskFieldsClear(common.AllFields)
-	macro.Register(PAD_CFG_DW0).CntrMaskFieldsClear(common.AllFields)
-	macro.Register(PAD_CFG_DW0).ValueSet(dw0).ReadOnlyFieldsSet(PAD_CFG_DW0_RO_FIELDS)
-	macro.Register(PAD_CFG_DW1).ValueSet(dw1).ReadOnlyFieldsSet(PAD_CFG_DW1_RO_FIELDS)
+	macro.PadIdSet(id).SetPadOwnership(ownership).SetPadLock(lock).SetPadInterrupt(interrupt).
+			SetInfoLevel(level)
+	macro.RegistersSet(dw, []uint32{PAD_CFG_DW0_RO_FIELDS, PAD_CFG_DW1_RO_FIELDS})
 	return macro.Generate()
 }
@@ -2,6 +2,9 @@ package apl
 
 import "strings"
 
+// Local packages
+import "../common"
+
 // GroupNameExtract - This function extracts the group ID, if it exists in a row
 // line      : string from the configuration file
 // return
@@ -12,6 +15,60 @@ func (PlatformSpecific) GroupNameExtract(line string) (bool, string) {
 	return false, ""
 }
 
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// See src/soc/intel/apollolake/include/soc/gpio_apl.h, the group of the pads
+	// is the range of the pad indexes within the community
+	var groups = map[uint8]string{
+		0x0: "PMC_GPE_SW_31_0",
+		0x1: "PMC_GPE_SW_63_32",
+		0x3: "PMC_GPE_NW_31_0",
+		0x4: "PMC_GPE_NW_63_32",
+		0x5: "PMC_GPE_NW_95_64",
+		0x6: "PMC_GPE_N_31_0",
+		0x7: "PMC_GPE_N_63_32",
+		0x8: "PMC_GPE_N_95_64",
+		0x9: "PMC_GPE_W_31_0",
+		0xa: "PMC_GPE_W_63_32",
+	}
+	group, valid := groups[value]
+	return valid, group
+}
+
+// See src/soc/intel/apollolake/include/soc/gpio_apl.h
+// The pads of Apollo Lake are not divided into groups, the index of the pad within
+// the community is calculated only from the offset of its configuration registers.
+// Each community is a separate GPIO controller in Linux (pinctrl-broxton.c), so
+// the pins of the community are numbered from 0.
+var communities = []common.Community{
+	{Name: "N",  Base: 0x500, Stride: 8, HostSwOwn: 0x80},
+	{Name: "NW", Base: 0x500, Stride: 8, HostSwOwn: 0x80},
+	{Name: "W",  Base: 0x500, Stride: 8, HostSwOwn: 0x80},
+	{Name: "SW", Base: 0x500, Stride: 8, HostSwOwn: 0x80},
+}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	return communities
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
 // KeywordCheck - This function is used to filter parsed lines of the configuration file and
 //                returns true if the keyword is contained in the line.
 // line      : string from the configuration file
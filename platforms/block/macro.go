@@ -0,0 +1,137 @@
+package block
+
+import "fmt"
+
+// Local packages
+import "../../fields"
+import "../common"
+import "../snr"
+
+const (
+	PAD_CFG_DW0_RO_FIELDS = (0x1 << 27) | (0x1 << 24) | (0x3 << 21) | (0xf << 16) | 0xfc
+	PAD_CFG_DW1_RO_FIELDS = 0xfdffc3ff
+	// Only the debounce is configured in PAD_CFG_DW2
+	PAD_CFG_DW2_RO_FIELDS = ^(common.DebounceMask | common.DebounceEnableMask)
+)
+
+// PMODE has 4 bits on the platforms with the common GPIO block, the upper bit
+// is reserved on the earlier platforms
+const PadModeMask uint32 = 0xf << common.PadModeShift
+
+const (
+	PAD_CFG_DW0 = common.PAD_CFG_DW0
+	PAD_CFG_DW1 = common.PAD_CFG_DW1
+	MAX_DW_NUM  = common.MAX_DW_NUM
+)
+
+// Reset mapping of the GPP communities, used if the pad is not found in the
+// community descriptors
+var rstMapGpp = []uint8{common.RST_RSMRST, common.RST_DEEP, common.RST_PLTRST}
+
+type InheritanceMacro interface {
+	Pull()
+	GpiMacroAdd()
+	GpoMacroAdd()
+	NativeFunctionMacroAdd()
+	NoConnMacroAdd()
+}
+
+// PlatformSpecific - the platforms with the common GPIO block of coreboot
+// (src/soc/intel/common/block/gpio) with the debounce in PAD_CFG_DW2: Tiger Lake,
+// Alder Lake, Meteor Lake, Elkhart Lake and Jasper Lake. The platform package
+// only describes the communities and their reset maps in InheritanceTemplate.
+// Cannon Lake and Emmitsburg only inherit the termination spelling, see Pull().
+type PlatformSpecific struct {
+	InheritanceMacro
+	InheritanceTemplate
+}
+
+// RemmapRstSrc - remmap Pad Reset Source Config
+func (platform PlatformSpecific) RemmapRstSrc() {
+	// See the reset maps of the communities in src/soc/intel/<soc>/gpio.c
+	macro := common.GetMacro()
+	rstmap := rstMapGpp
+	if valid, community, _ := common.PadGroupFind(platform.CommunitiesGet(nil),
+			macro.PadIdGet()); valid {
+		rstmap = community.ResetMap
+	}
+	macro.ResetRemap(rstmap)
+}
+
+// Adds The Pad Termination (TERM) parameter from PAD_CFG_DW1 to the macro
+// as a new argument. The termination is not inherited from Sunrise, the common
+// GPIO block spells it as UP_20K instead of 20K_PU.
+func (PlatformSpecific) Pull() {
+	macro := common.GetMacro()
+	dw1 := macro.Register(PAD_CFG_DW1)
+	// See src/soc/intel/common/block/include/intelblocks/gpio_defs.h
+	var pull = map[uint8]string{
+		0x0: "NONE",
+		0x2: "DN_5K",
+		0x4: "DN_20K",
+		0x9: "UP_1K",
+		0xa: "UP_5K",
+		0xb: "UP_2K",
+		0xc: "UP_20K",
+		0xd: "UP_667",
+		0xf: "NATIVE",
+	}
+	str, valid := pull[dw1.GetTermination()]
+	if !valid {
+		str = "INVALID"
+		fmt.Println("Error",
+				macro.PadIdGet(),
+				" invalid TERM value = ",
+				int(dw1.GetTermination()))
+	}
+	macro.Separator().Add(str)
+}
+
+// Adds PAD_CFG_GPI macro with arguments
+func (platform PlatformSpecific) GpiMacroAdd() {
+	platform.InheritanceMacro.GpiMacroAdd()
+}
+
+// Adds PAD_CFG_GPO macro with arguments
+func (platform PlatformSpecific) GpoMacroAdd() {
+	platform.InheritanceMacro.GpoMacroAdd()
+}
+
+// Adds PAD_CFG_NF macro with arguments
+func (platform PlatformSpecific) NativeFunctionMacroAdd() {
+	platform.InheritanceMacro.NativeFunctionMacroAdd()
+}
+
+// Adds PAD_NC macro
+func (platform PlatformSpecific) NoConnMacroAdd() {
+	platform.InheritanceMacro.NoConnMacroAdd()
+}
+
+// GenMacro - generate pad macro
+// dw : values of the pad configuration registers
+// lock : pad configuration lock state
+// interrupt : driver-mode interrupt state
+// level : info level of the comments in the macro
+// return: string of macro
+//         error
+func (platform PlatformSpecific) GenMacro(id string, dw [MAX_DW_NUM]uint32, ownership uint8, lock uint8, interrupt uint8, level uint8) string {
+	// The macros of the common GPIO block are the same as for Sunrise, only the
+	// reset mapping, the debounce and the virtual GPIOs are specific.
+	macro := common.GetInstanceMacro(
+			PlatformSpecific{
+				InheritanceMacro    : snr.PlatformSpecific{},
+				InheritanceTemplate : platform.InheritanceTemplate,
+			},
+			fields.InterfaceGet())
+	macro.Clear()
+	macro.PadIdSet(id).SetPadOwnership(ownership).SetPadLock(lock).SetPadInterrupt(interrupt).
+			SetInfoLevel(level)
+	macro.RegistersSet(dw, []uint32{PAD_CFG_DW0_RO_FIELDS, PAD_CFG_DW1_RO_FIELDS,
+			PAD_CFG_DW2_RO_FIELDS})
+	macro.Register(PAD_CFG_DW0).PadModeMaskSet(PadModeMask)
+	if valid, _, group := common.PadGroupFind(platform.CommunitiesGet(nil), id); valid &&
+			group.Virtual {
+		return macro.GenerateVirtual()
+	}
+	return macro.Generate()
+}
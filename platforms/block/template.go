@@ -0,0 +1,54 @@
+package block
+
+// Local packages
+import "../common"
+
+type InheritanceTemplate interface {
+	GroupNameExtract(line string) (bool, string)
+	GpeGroupNameGet(value uint8) (bool, string)
+	CommunitiesGet(groups []string) []common.Community
+	NativeFunctionGet(id string, mode uint8) (bool, string)
+	KeywordCheck(line string) bool
+}
+
+// GroupNameExtract - This function extracts the group ID, if it exists in a row
+// line      : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func (platform PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	return platform.InheritanceTemplate.GroupNameExtract(line)
+}
+
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (platform PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	return platform.InheritanceTemplate.GpeGroupNameGet(value)
+}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (platform PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	return platform.InheritanceTemplate.CommunitiesGet(groups)
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (platform PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	return platform.InheritanceTemplate.NativeFunctionGet(id, mode)
+}
+
+// KeywordCheck - This function is used to filter parsed lines of the configuration file and
+//                returns true if the keyword is contained in the line.
+// line      : string from the configuration file
+func (platform PlatformSpecific) KeywordCheck(line string) bool {
+	return platform.InheritanceTemplate.KeywordCheck(line)
+}
@@ -0,0 +1,125 @@
+package bsw
+
+import "fmt"
+
+// Local packages
+import "../common"
+import "../../config"
+
+// The pad registers of the dump: PAD_CONF0 and PAD_CONF1
+const (
+	PAD_CONF0 = 0
+	PAD_CONF1 = 1
+)
+
+// Bit fields of PAD_CONF0 and PAD_CONF1, see src/soc/intel/braswell/include/soc/gpio.h
+const (
+	PadRxState      uint32 = 1 << 0
+	PadTxState      uint32 = 1 << 1
+	PadGpioCfgShift uint8  = 8
+	PadGpioCfgMask  uint32 = 0x7 << PadGpioCfgShift
+	PadGpioEnable   uint32 = 1 << 15
+	PadModeShift    uint8  = 16
+	PadModeMask     uint32 = 0xf << PadModeShift
+	PadTermShift    uint8  = 20
+	PadTermMask     uint32 = 0x7 << PadTermShift
+	PadPullUp       uint32 = 1 << 23
+
+	// PAD_CONF1: configuration lock
+	PadConf1Lock    uint32 = 1 << 31
+)
+
+// Fields of PAD_CONF0 that the native and GPIO macros set
+const (
+	PadNativeFields uint32 = PadModeMask | PadTermMask | PadPullUp
+	PadGpioFields   uint32 = PadGpioEnable | PadGpioCfgMask | PadTermMask | PadPullUp
+)
+
+// GPIO configuration from the GPIOCFG field of PAD_CONF0
+const (
+	PadGpioCfgGpio uint32 = 0
+	PadGpioCfgGpo  uint32 = 1
+	PadGpioCfgGpi  uint32 = 2
+	PadGpioCfgHiz  uint32 = 3
+)
+
+var term = map[uint32]string{
+	0: "",
+	1: "20K",
+	2: "5K",
+	4: "1K",
+}
+
+type PlatformSpecific struct {}
+
+// padCheck - returns true if PAD_CONF0 contains only the fields that the macro
+// sets. RX state is the state of the pin and is not compared
+// conf0  : PAD_CONF0 register
+// fields : fields of the macro
+func padCheck(conf0 uint32, fields uint32) bool {
+	return conf0 &^ (PadRxState | fields) == 0
+}
+
+// GenMacro - generate the entry of struct soc_gpio_map for the pad. The macro
+// is used only if it expands to the values of both registers of the pad. The
+// macros do not set PAD_CONF1, so only its lock bit may be set
+// community : community name from the dump
+// index     : pad number in the community
+// dw        : PAD_CONF0 and PAD_CONF1 registers
+// return: string of the entry
+//     NATIVE_PU20K(1),
+//     { .pad_conf0 = 0x00028302, .pad_conf1 = 0x00000002 },
+func (PlatformSpecific) GenMacro(community string, index int, dw [common.MAX_DW_NUM]uint32) string {
+	conf0, conf1 := dw[PAD_CONF0], dw[PAD_CONF1]
+	pull, valid := term[(conf0 & PadTermMask) >> PadTermShift]
+	fields := PadNativeFields
+	if conf0 & PadGpioEnable != 0 {
+		fields = PadGpioFields | PadTxState
+	}
+	if pull == "" {
+		// without the termination, the macros do not set the pull-up bit either
+		fields &^= PadPullUp
+	}
+	if !config.IsRawFields() && valid && conf1 &^ PadConf1Lock == 0 && padCheck(conf0, fields) {
+		if pull != "" && conf0 & PadPullUp != 0 {
+			pull = "PU" + pull
+		} else if pull != "" {
+			pull = "PD" + pull
+		}
+		if conf0 & PadGpioEnable == 0 {
+			mode := (conf0 & PadModeMask) >> PadModeShift
+			switch pull {
+			case "":
+				return fmt.Sprintf("Native_M%d,", mode)
+			case "PU20K", "PD20K", "PU5K", "PD5K":
+				return fmt.Sprintf("NATIVE_%s(%d),", pull, mode)
+			}
+		} else {
+			switch (conf0 & PadGpioCfgMask) >> PadGpioCfgShift {
+			case PadGpioCfgGpo:
+				if pull == "" && conf0 & PadTxState != 0 {
+					return "GPIO_OUT_HIGH,"
+				} else if pull == "" {
+					return "GPIO_OUT_LOW,"
+				}
+			case PadGpioCfgGpi:
+				if conf0 & PadTxState != 0 {
+					break
+				}
+				var input = map[string]string{
+					"":      "GPIO_INPUT_NO_PULL",
+					"PU20K": "GPIO_INPUT_PU_20K",
+					"PD20K": "GPIO_INPUT_PD_20K",
+				}
+				if macro, valid := input[pull]; valid {
+					return macro + ","
+				}
+			case PadGpioCfgHiz:
+				if pull == "" && conf0 & PadTxState == 0 {
+					return "GPIO_NC,"
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("{ .pad_conf0 = 0x%0.8x, .pad_conf1 = 0x%0.8x },", conf0, conf1)
+}
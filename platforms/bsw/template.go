@@ -0,0 +1,16 @@
+package bsw
+
+import "../common"
+
+// GPIO communities of Braswell, see src/soc/intel/braswell/include/soc/gpio.h
+var tables = []common.SocTable{
+	{Community: "SOUTHWEST", Name: "gpsw_gpio_map", Member: "southwest"},
+	{Community: "NORTH",     Name: "gpn_gpio_map",  Member: "north"},
+	{Community: "EAST",      Name: "gpe_gpio_map",  Member: "east"},
+	{Community: "SOUTHEAST", Name: "gpse_gpio_map", Member: "southeast"},
+}
+
+// TablesGet - returns the tables of the GPIO communities
+func (PlatformSpecific) TablesGet() []common.SocTable {
+	return tables
+}
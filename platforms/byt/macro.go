@@ -0,0 +1,173 @@
+package byt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Local packages
+import "../common"
+import "../../config"
+
+// The pad registers of the dump: PCONF0, PCONF1 and PAD_VAL
+const (
+	PAD_CONF0 = 0
+	PAD_CONF1 = 1
+	PAD_VAL   = 2
+)
+
+// Bit fields of PCONF0 and PAD_VAL, see src/soc/intel/baytrail/include/soc/gpio.h
+const (
+	PadFuncMask     uint32 = 0x7
+	PadPullShift    uint8  = 7
+	PadPullMask     uint32 = 0x3 << PadPullShift
+	PadPullStrShift uint8  = 9
+	PadPullStrMask  uint32 = 0x3 << PadPullStrShift
+	PadIrqMask      uint32 = 0xf << 24
+
+	PadValLevel         uint32 = 1 << 0
+	PadValOutputDisable uint32 = 1 << 1
+	PadValInputDisable  uint32 = 1 << 2
+	PadValMask          uint32 = 0x7
+)
+
+// Power-on values from src/soc/intel/baytrail/include/soc/gpio.h that the
+// GPIO_FUNC(), GPIO_INPUT_* and GPIO_OUT_* macros add to the pad configuration:
+// PAD_CONFIG0_DEFAULT, PAD_CONFIG1_DEFAULT, PAD_VAL_INPUT and PAD_VAL_OUTPUT.
+// GPIO_FUNC() uses PAD_VAL_DEFAULT, which is the same as PAD_VAL_INPUT
+const (
+	PadConfig0Default uint32 = 0x2003c000
+	PadConfig1Default uint32 = 0x8000
+	PadValInput       uint32 = PadValOutputDisable
+	PadValOutput      uint32 = PadValInputDisable
+)
+
+// GPIO macros with the pull fields of PCONF0 and PAD_VAL they expand to
+var gpioMacros = []struct {
+	name  string
+	conf0 uint32
+	val   uint32
+}{
+	{"GPIO_OUT_HIGH",     0, PadValOutput | PadValLevel},
+	{"GPIO_OUT_LOW",      0, PadValOutput},
+	{"GPIO_INPUT_NOPU",   2 << PadPullStrShift, PadValInput},
+	{"GPIO_INPUT_PU_20K", 1 << PadPullShift | 2 << PadPullStrShift, PadValInput},
+	{"GPIO_INPUT_PD_20K", 2 << PadPullShift | 2 << PadPullStrShift, PadValInput},
+	{"GPIO_INPUT_PU_10K", 1 << PadPullShift | 1 << PadPullStrShift, PadValInput},
+	{"GPIO_INPUT_PD_10K", 2 << PadPullShift | 1 << PadPullStrShift, PadValInput},
+}
+
+var pull = []string{"PULL_DISABLE", "PULL_UP", "PULL_DOWN"}
+var strength = []string{"2K", "10K", "20K", "40K"}
+
+// Single-bit fields of PCONF0 that have names in coreboot
+var conf0bits = []struct {
+	mask uint32
+	name string
+}{
+	{1 << 27, "PAD_IRQ_EN"},
+	{1 << 26, "PAD_TNE_IRQ"},
+	{1 << 25, "PAD_TPE_IRQ"},
+	{1 << 24, "PAD_LEVEL_IRQ"},
+	{1 << 17, "PAD_SLOWGF_ENABLE"},
+	{1 << 16, "PAD_FASTGF_ENABLE"},
+	{1 << 15, "PAD_HYST_DISABLE"},
+}
+
+type PlatformSpecific struct {}
+
+// padConf0Get - returns PCONF0 as the bit field macros:
+// PAD_FUNC1 | PAD_PULL_UP | PAD_PU_20K | PAD_SLOWGF_ENABLE
+func padConf0Get(conf0 uint32) string {
+	fields := []string{fmt.Sprintf("PAD_FUNC%d", conf0 & PadFuncMask)}
+	rest := conf0 &^ (PadFuncMask | PadPullMask | PadPullStrMask)
+	if number := (conf0 & PadPullMask) >> PadPullShift; int(number) < len(pull) {
+		fields = append(fields, "PAD_" + pull[number])
+	} else {
+		rest |= conf0 & PadPullMask
+	}
+	if conf0 & (PadPullMask | PadPullStrMask) != 0 {
+		fields = append(fields, "PAD_PU_" + strength[(conf0 & PadPullStrMask) >> PadPullStrShift])
+	}
+	for _, bit := range conf0bits {
+		if rest & bit.mask != 0 {
+			fields = append(fields, bit.name)
+			rest &^= bit.mask
+		}
+	}
+	if rest != 0 {
+		fields = append(fields, fmt.Sprintf("0x%x", rest))
+	}
+	return strings.Join(fields, " | ")
+}
+
+// padValGet - returns PAD_VAL as the bit field macros. The level is added only
+// for the output, since for the input it is the state of the pin
+func padValGet(val uint32) string {
+	var fields []string
+	if val & PadValInputDisable != 0 {
+		fields = append(fields, "PAD_VAL_INPUT_DISABLE")
+	} else {
+		fields = append(fields, "PAD_VAL_INPUT_ENABLE")
+	}
+	if val & PadValOutputDisable != 0 {
+		fields = append(fields, "PAD_VAL_OUTPUT_DISABLE")
+	} else if val & PadValLevel != 0 {
+		fields = append(fields, "PAD_VAL_OUTPUT_ENABLE", "PAD_VAL_HIGH")
+	} else {
+		fields = append(fields, "PAD_VAL_OUTPUT_ENABLE", "PAD_VAL_LOW")
+	}
+	if rest := val &^ PadValMask; rest != 0 {
+		fields = append(fields, fmt.Sprintf("0x%x", rest))
+	}
+	return strings.Join(fields, " | ")
+}
+
+// padCheck - returns true if the registers of the pad are equal to the
+// expansion of the macro. For the input, the level of PAD_VAL is the state of
+// the pin and is not compared
+// dw    : PCONF0, PCONF1 and PAD_VAL registers
+// conf0 : function and pull fields of the macro
+// val   : PAD_VAL of the macro
+func padCheck(dw [common.MAX_DW_NUM]uint32, conf0 uint32, val uint32) bool {
+	padval := dw[PAD_VAL]
+	if val & PadValOutputDisable != 0 {
+		padval &^= PadValLevel
+	}
+	return dw[PAD_CONF0] == PadConfig0Default | conf0 &&
+			dw[PAD_CONF1] == PadConfig1Default && padval == val
+}
+
+// GenMacro - generate the entry of struct soc_gpio_map for the pad. The macro
+// is used only if it expands to the values of all registers of the pad
+// community : community name from the dump
+// index     : GPIO number of the pad in the community
+// dw        : PCONF0, PCONF1 and PAD_VAL registers
+// return: string of the entry
+//     GPIO_FUNC(1, PULL_UP, 20K),
+//     { .pad_conf0 = PAD_FUNC0 | PAD_PULL_DISABLE | PAD_TNE_IRQ, .pad_conf1 = 0x05c00000,
+//       .pad_val = PAD_VAL_INPUT_ENABLE | PAD_VAL_OUTPUT_DISABLE },
+func (PlatformSpecific) GenMacro(community string, index int, dw [common.MAX_DW_NUM]uint32) string {
+	conf0, conf1, val := dw[PAD_CONF0], dw[PAD_CONF1], dw[PAD_VAL]
+	if config.IsRawFields() {
+		return fmt.Sprintf("{ .pad_conf0 = 0x%0.8x, .pad_conf1 = 0x%0.8x, .pad_val = 0x%0.8x },",
+				conf0, conf1, val)
+	}
+	function := conf0 & PadFuncMask
+	if function != gpioMuxGet(community, index) {
+		number := (conf0 & PadPullMask) >> PadPullShift
+		fields := conf0 & (PadFuncMask | PadPullMask | PadPullStrMask)
+		if int(number) < len(pull) && padCheck(dw, fields, PadValInput) {
+			return fmt.Sprintf("GPIO_FUNC(%d, %s, %s),", function, pull[number],
+					strength[(conf0 & PadPullStrMask) >> PadPullStrShift])
+		}
+	} else {
+		for _, macro := range gpioMacros {
+			if padCheck(dw, function | macro.conf0, macro.val) {
+				return macro.name + ","
+			}
+		}
+	}
+	return fmt.Sprintf("{ .pad_conf0 = %s, .pad_conf1 = 0x%0.8x, .pad_val = %s },",
+			padConf0Get(conf0), conf1, padValGet(val))
+}
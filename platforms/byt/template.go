@@ -0,0 +1,34 @@
+package byt
+
+import "../common"
+
+// GPIO communities of Bay Trail, see src/soc/intel/baytrail/include/soc/gpio.h
+var tables = []common.SocTable{
+	{Community: "SCORE", Name: "gpscore_gpio_map", Member: "score", Size: 102},
+	{Community: "NCORE", Name: "gpncore_gpio_map", Member: "ncore", Size: 28},
+	{Community: "SUS",   Name: "gpssus_gpio_map",  Member: "ssus",  Size: 44},
+}
+
+// The GPIO function of most pads is 0, except SCORE pads 92-93 and SUS pads
+// 11-21, see byt_get_gpio_mux() in drivers/pinctrl/intel/pinctrl-baytrail.c
+// of Linux
+const (
+	GpioMuxDefault uint32 = 0
+	GpioMuxAlter   uint32 = 1
+)
+
+// gpioMuxGet - returns the pin mux value of the GPIO function of the pad
+// community : community name
+// index     : GPIO number of the pad in the community
+func gpioMuxGet(community string, index int) uint32 {
+	if (community == "SCORE" && index >= 92 && index <= 93) ||
+			(community == "SUS" && index >= 11 && index <= 21) {
+		return GpioMuxAlter
+	}
+	return GpioMuxDefault
+}
+
+// TablesGet - returns the tables of the GPIO communities
+func (PlatformSpecific) TablesGet() []common.SocTable {
+	return tables
+}
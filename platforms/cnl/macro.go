@@ -0,0 +1,98 @@
+package cnl
+
+// Local packages
+import "../../fields"
+import "../block"
+import "../common"
+import "../snr"
+
+const (
+	PAD_CFG_DW0_RO_FIELDS = (0x1 << 27) | (0x1 << 24) | (0x3 << 21) | (0xf << 16) | 0xfc
+	PAD_CFG_DW1_RO_FIELDS = 0xfdffc3ff
+)
+
+const (
+	PAD_CFG_DW0 = common.PAD_CFG_DW0
+	PAD_CFG_DW1 = common.PAD_CFG_DW1
+	MAX_DW_NUM  = common.MAX_DW_NUM
+)
+
+type InheritanceMacro interface {
+	Pull()
+	GpiMacroAdd()
+	GpoMacroAdd()
+	NativeFunctionMacroAdd()
+	NoConnMacroAdd()
+}
+
+type PlatformSpecific struct {
+	InheritanceMacro
+}
+
+// RemmapRstSrc - remmap Pad Reset Source Config
+func (platform PlatformSpecific) RemmapRstSrc() {
+	// See rst_map and rst_map_com2 for the GPD Group in the Community 2:
+	// https://github.com/coreboot/coreboot/blob/master/src/soc/intel/cannonlake/gpio.c
+	macro := common.GetMacro()
+	rstmap := rstMapGpp
+	if valid, community, _ := common.PadGroupFind(platform.CommunitiesGet(nil),
+			macro.PadIdGet()); valid {
+		rstmap = community.ResetMap
+	}
+	macro.ResetRemap(rstmap)
+}
+
+// Adds The Pad Termination (TERM) parameter from PAD_CFG_DW1 to the macro
+// as a new argument
+func (platform PlatformSpecific) Pull() {
+	platform.InheritanceMacro.Pull()
+}
+
+// Adds PAD_CFG_GPI macro with arguments
+func (platform PlatformSpecific) GpiMacroAdd() {
+	platform.InheritanceMacro.GpiMacroAdd()
+}
+
+// Adds PAD_CFG_GPO macro with arguments
+func (platform PlatformSpecific) GpoMacroAdd() {
+	platform.InheritanceMacro.GpoMacroAdd()
+}
+
+// Adds PAD_CFG_NF macro with arguments
+func (platform PlatformSpecific) NativeFunctionMacroAdd() {
+	platform.InheritanceMacro.NativeFunctionMacroAdd()
+}
+
+// Adds PAD_NC macro
+func (platform PlatformSpecific) NoConnMacroAdd() {
+	platform.InheritanceMacro.NoConnMacroAdd()
+}
+
+// GenMacro - generate pad macro
+// dw : values of the pad configuration registers
+// lock : pad configuration lock state
+// interrupt : driver-mode interrupt state
+// level : info level of the comments in the macro
+// return: string of macro
+//         error
+func (platform PlatformSpecific) GenMacro(id string, dw [MAX_DW_NUM]uint32, ownership uint8, lock uint8, interrupt uint8, level uint8) string {
+	// The macros of Cannon Point are the same as for Sunrise, only the reset
+	// mapping and the virtual GPIOs are specific. The termination is spelled as
+	// in the common GPIO block.
+	macro := common.GetInstanceMacro(
+			PlatformSpecific{
+				InheritanceMacro : block.PlatformSpecific{
+					InheritanceMacro : snr.PlatformSpecific{},
+				},
+			},
+			fields.InterfaceGet())
+	macro.Clear()
+	macro.PadIdSet(id).SetPadOwnership(ownership).SetPadLock(lock).SetPadInterrupt(interrupt).
+			SetInfoLevel(level)
+	macro.RegistersSet(dw, []uint32{PAD_CFG_DW0_RO_FIELDS, PAD_CFG_DW1_RO_FIELDS})
+	if valid, _, group := common.PadGroupFind(platform.CommunitiesGet(nil), id); valid &&
+			group.Virtual {
+		return macro.GenerateVirtual()
+	}
+	return macro.Generate()
+}
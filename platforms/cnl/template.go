@@ -0,0 +1,210 @@
+package cnl
+
+// Local packages
+import "../common"
+import "../../config"
+
+// Virtual GPIOs of CNVi and the UART/I2S bridges, see
+// drivers/pinctrl/intel/pinctrl-cannonlake.c in Linux
+var vgpio = []string{
+	"CNV_BTEN", "CNV_GNEN", "CNV_WFEN", "CNV_WCEN",
+	"CNV_BT_HOST_WAKEB", "vCNV_GNSS_HOST_WAKEB", "vSD3_CD_B", "CNV_BT_IF_SELECT",
+	"vCNV_BT_UART_TXD", "vCNV_BT_UART_RXD", "vCNV_BT_UART_CTS_B", "vCNV_BT_UART_RTS_B",
+	"vCNV_MFUART1_TXD", "vCNV_MFUART1_RXD", "vCNV_MFUART1_CTS_B", "vCNV_MFUART1_RTS_B",
+	"vCNV_GNSS_UART_TXD", "vCNV_GNSS_UART_RXD", "vCNV_GNSS_UART_CTS_B", "vCNV_GNSS_UART_RTS_B",
+	"vUART0_TXD", "vUART0_RXD", "vUART0_CTS_B", "vUART0_RTS_B",
+	"vISH_UART0_TXD", "vISH_UART0_RXD", "vISH_UART0_CTS_B", "vISH_UART0_RTS_B",
+	"vISH_UART1_TXD", "vISH_UART1_RXD", "vISH_UART1_CTS_B", "vISH_UART1_RTS_B",
+	"vCNV_BT_I2S_BCLK", "vCNV_BT_I2S_WS_SYNC", "vCNV_BT_I2S_SDO", "vCNV_BT_I2S_SDI",
+	"vSSP2_SCLK", "vSSP2_SFRM", "vSSP2_TXD", "vSSP2_RXD",
+}
+
+var spi = []string{
+	"SPI0_IO_2", "SPI0_IO_3", "SPI0_MOSI_IO_0", "SPI0_MISO_IO_1",
+	"SPI0_FLASH_0_CSB", "SPI0_FLASH_1_CSB", "SPI0_FLASH_2_CSB", "SPI0_CLK", "SPI0_CLK_LOOPBK",
+}
+
+var jtag = []string{
+	"JTAG_TDO", "JTAGX", "PRDYB", "PREQB", "CPU_TRSTB", "JTAG_TDI", "JTAG_TMS", "JTAG_TCK",
+	"ITP_PMODE",
+}
+
+// Reset mapping of the GPP communities, the mapping of GPD is the same as the
+// logical one
+var rstMapGpp = []uint8{common.RST_RSMRST, common.RST_DEEP, common.RST_PLTRST}
+
+// See src/soc/intel/cannonlake/include/soc/gpio_soc_defs.h and
+// drivers/pinctrl/intel/pinctrl-cannonlake.c in Linux. The Community 3 (HDA and
+// CPU pads) and GPD are not exposed by the Linux driver.
+var communitiesLp = []common.Community{
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_A", Size: 24, GpioBase: 0, Pads: []string{"ESPI_CLK_LOOPBK"}},
+		{Name: "GPP_B", Size: 24, GpioBase: 32,
+				Pads: []string{"GSPI0_CLK_LOOPBK", "GSPI1_CLK_LOOPBK"}},
+		{Name: "GPP_G", Size: 8, GpioBase: 64},
+		{Name: "SPI", GpioBase: common.GpioBaseNoMap, Pads: spi},
+	}},
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, ResetMap: rstMapGpp,
+		PinBase: 68, Groups: []common.Group{
+		{Name: "GPP_D", Size: 24, GpioBase: 96, Pads: []string{"GSPI2_CLK_LOOPBK"}},
+		{Name: "GPP_F", Size: 24, GpioBase: 128},
+		{Name: "GPP_H", Size: 24, GpioBase: 160},
+		{Name: "vGPIO", GpioBase: 192, Pads: vgpio, Virtual: true},
+	}},
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, PinBase: -1, Groups: []common.Group{
+		{Name: "GPD", Size: 12},
+	}},
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, ResetMap: rstMapGpp,
+		PinBase: -1},
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, ResetMap: rstMapGpp,
+		PinBase: 181, Groups: []common.Group{
+		{Name: "GPP_C", Size: 24, GpioBase: 256},
+		{Name: "HVCMOS", GpioBase: common.GpioBaseNoMap, Pads: []string{
+			"L_BKLTEN", "L_BKLTCTL", "L_VDDEN", "SYS_PWROK", "SYS_RESETB", "MLK_RSTB",
+		}},
+		{Name: "GPP_E", Size: 24, GpioBase: 288},
+		{Name: "JTAG", GpioBase: common.GpioBaseNoMap, Pads: jtag},
+	}},
+}
+
+var communitiesH = []common.Community{
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_A", Size: 24, GpioBase: 0, Pads: []string{"ESPI_CLK_LOOPBK"}},
+		{Name: "GPP_B", Size: 24, GpioBase: 32,
+				Pads: []string{"GSPI0_CLK_LOOPBK", "GSPI1_CLK_LOOPBK"}},
+	}},
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, ResetMap: rstMapGpp,
+		PinBase: 51, Groups: []common.Group{
+		{Name: "GPP_C", Size: 24, GpioBase: 64},
+		{Name: "GPP_D", Size: 24, GpioBase: 96},
+		{Name: "GPP_G", Size: 8, GpioBase: 128},
+		{Name: "AZA", GpioBase: 160, Pads: []string{
+			"HDA_BCLK", "HDA_RSTB", "HDA_SYNC", "HDA_SDO", "HDA_SDI_0", "HDA_SDI_1",
+			"I2S1_SFRM", "I2S1_TXD",
+		}},
+		{Name: "vGPIO_0", GpioBase: 192, Pads: vgpio[:32], Virtual: true},
+		{Name: "vGPIO_1", GpioBase: 224, Pads: vgpio[32:], Virtual: true},
+	}},
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, PinBase: -1, Groups: []common.Group{
+		{Name: "GPD", Size: 12},
+	}},
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, ResetMap: rstMapGpp,
+		PinBase: 155, Groups: []common.Group{
+		{Name: "GPP_K", Size: 24, GpioBase: 256},
+		{Name: "GPP_H", Size: 24, GpioBase: 288},
+		{Name: "GPP_E", Size: 13, GpioBase: 320},
+		{Name: "GPP_F", Size: 24, GpioBase: 352},
+		{Name: "SPI", GpioBase: common.GpioBaseNoMap, Pads: spi},
+	}},
+	{Base: 0x600, Stride: 16, HostSwOwn: 0xd0, ResetMap: rstMapGpp,
+		PinBase: 249, Groups: []common.Group{
+		{Name: "CPU", GpioBase: common.GpioBaseNoMap, Pads: []string{
+			"HDACPU_SDI", "HDACPU_SDO", "HDACPU_SCLK", "PM_SYNC", "PECI", "CPUPWRGD",
+			"CPU_THRMTRIP_B", "PLTRST_CPUB", "PM_DOWN", "TRIGGER_IN", "TRIGGER_OUT",
+		}},
+		{Name: "JTAG", GpioBase: common.GpioBaseNoMap, Pads: jtag},
+		{Name: "GPP_I", Size: 15, GpioBase: 384,
+				Pads: []string{"SYS_PWROK", "SYS_RESETB", "MLK_RSTB"}},
+		{Name: "GPP_J", Size: 12, GpioBase: 416},
+	}},
+}
+
+// SkuDetect - returns the PCH SKU with the pad groups from the dump
+// groups : pad groups from the configuration file, GPP_I, GPP_J and GPP_K are
+//          only present in the PCH-H
+func SkuDetect(groups []string) string {
+	for _, group := range groups {
+		if group == "GPP_I" || group == "GPP_J" || group == "GPP_K" {
+			return "h"
+		}
+	}
+	return "lp"
+}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file. The groups are not used if
+//          the SKU is set.
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	if config.IsSkuSet() && config.IsSku("h") || !config.IsSkuSet() && SkuDetect(groups) == "h" {
+		return communitiesH
+	}
+	return communitiesLp
+}
+
+// scanCommunitiesGet - returns the descriptors used to scan the dump. The SKU is
+// detected after the scan, so the groups of both SKUs are searched if the SKU
+// is not set.
+func scanCommunitiesGet() []common.Community {
+	if config.IsSkuSet() {
+		return PlatformSpecific{}.CommunitiesGet(nil)
+	}
+	return append(append([]common.Community{}, communitiesLp...), communitiesH...)
+}
+
+// GroupNameExtract - This function extracts the group ID, if it exists in a row
+// line      : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func (PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	return common.GroupNameExtract(scanCommunitiesGet(), line)
+}
+
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// See src/soc/intel/cannonlake/include/soc/gpe.h
+	var groups = map[uint8]string{
+		0x0: "GPP_A",
+		0x1: "GPP_B",
+		0x2: "GPP_G",
+		0x3: "GPP_D",
+		0x4: "GPP_F",
+		0x5: "GPP_H",
+		0x6: "GPD",
+		0x7: "GPP_C",
+		0x8: "GPP_E",
+	}
+	if config.IsSku("h") {
+		groups = map[uint8]string{
+			0x0: "GPP_A",
+			0x1: "GPP_B",
+			0x2: "GPP_C",
+			0x3: "GPP_D",
+			0x4: "GPP_G",
+			0x5: "GPP_K",
+			0x6: "GPP_H",
+			0x7: "GPP_E",
+			0x8: "GPP_F",
+			0x9: "GPP_I",
+			0xa: "GPP_J",
+			0xb: "GPD",
+		}
+	}
+	group, valid := groups[value]
+	return valid, group
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// KeywordCheck - This function is used to filter parsed lines of the configuration file and
+//                returns true if the keyword is contained in the line.
+// line      : string from the configuration file
+func (PlatformSpecific) KeywordCheck(line string) bool {
+	return common.KeywordCheck(scanCommunitiesGet(), line)
+}
@@ -0,0 +1,279 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Linux gpiochip offset of the first pad of the group, the same values as in
+// drivers/pinctrl/intel/pinctrl-intel.h
+const (
+	GpioBaseMatch int = 0  // the offset is the same as the pin number
+	GpioBaseNoMap int = -1 // the group is not mapped to the gpiochip
+	GpioBaseZero  int = -2 // the offset of the first pad is 0
+)
+
+// Group - pad group descriptor
+// Name     : group identifier, e.g. GPP_A, empty for the reserved pads that are
+//            not used by coreboot and whose names are not known
+// Size     : number of the numbered pads in the group, GPP_A0 ... GPP_A23
+// GpioBase : Linux gpiochip offset of the first pad, the offsets of the groups
+//            can have gaps between them
+// Pads     : names of the pads that follow the numbered pads, e.g. the loopback
+//            clocks or the virtual GPIOs
+// Virtual  : the pads of the group are virtual GPIOs without electrical properties
+type Group struct {
+	Name     string
+	Size     int
+	GpioBase int
+	Pads     []string
+	Virtual  bool
+}
+
+// PadNumGet - returns the number of all pads in the group
+func (group Group) PadNumGet() int {
+	return group.Size + len(group.Pads)
+}
+
+// PadNameGet - returns the name of the pad with the index within the group
+// index : pad index
+func (group Group) PadNameGet(index int) string {
+	if index < group.Size {
+		return group.Name + strconv.Itoa(index)
+	}
+	return group.Pads[index - group.Size]
+}
+
+// PadIndexGet - returns the index of the pad within the group by its name
+// id : pad ID string
+// return
+//     bool : true if the pad belongs to the group
+//     int  : pad index
+func (group Group) PadIndexGet(id string) (bool, int) {
+	if group.Name != "" && strings.HasPrefix(id, group.Name) {
+		if number, err := strconv.Atoi(strings.TrimPrefix(id, group.Name)); err == nil &&
+				number >= 0 && number < group.Size {
+			return true, number
+		}
+	}
+	for i, name := range group.Pads {
+		if name == id {
+			return true, group.Size + i
+		}
+	}
+	return false, 0
+}
+
+// Community - GPIO community descriptor
+// Name      : community name, empty if the communities are only numbered
+// Base      : PAD_CFG_BASE, offset of the configuration registers of the first pad
+//             relative to the community base address
+// Stride    : size of the configuration registers of one pad in bytes
+// HostSwOwn : offset of the first HOSTSW_OWN register, if the registers are not
+//             named after the pad groups in the dump
+// PinBase   : Linux pinctrl number of the first pad, -1 if the community is not
+//             exposed by the Linux driver
+// Groups    : pad groups of the community in the order of the pad indices
+// ResetMap  : logical reset (RST_*) for each PADRSTCFG value, see rst_map in
+//             src/soc/intel/<soc>/gpio.c, nil if the mapping is the same as the
+//             logical one
+// Die       : name of the die with the GPIO controller on the multi-die SoC,
+//             the communities of each die are numbered from 0 in the dump
+// Prefix    : prefix of the coreboot pad IDs of the die that is added to the
+//             names of the pads from the dump
+type Community struct {
+	Name      string
+	Base      uint16
+	Stride    uint16
+	HostSwOwn uint16
+	PinBase   int
+	Groups    []Group
+	ResetMap  []uint8
+	Die       string
+	Prefix    string
+}
+
+// PadGroupFind - finds the community and the group of the pad by its name
+// communities : community descriptors of the platform
+// id          : pad ID string
+// return
+//     bool      : true if the pad was found
+//     Community : community descriptor
+//     Group     : group descriptor
+func PadGroupFind(communities []Community, id string) (bool, Community, Group) {
+	for _, community := range communities {
+		for _, group := range community.Groups {
+			if valid, _ := group.PadIndexGet(id); valid {
+				return true, community, group
+			}
+		}
+	}
+	return false, Community{}, Group{}
+}
+
+// GroupIndexGet - returns the index of the first pad of the group within the
+// community
+// name : group identifier
+// return
+//     bool : true if the group belongs to the community
+//     int  : pad index
+func (community Community) GroupIndexGet(name string) (bool, int) {
+	index := 0
+	for _, group := range community.Groups {
+		if group.Name == name {
+			return true, index
+		}
+		index += group.PadNumGet()
+	}
+	return false, 0
+}
+
+// PadFind - returns the index of the pad within the community by its name
+// id : pad ID string
+// return
+//     bool : true if the pad belongs to the community
+//     int  : pad index
+func (community Community) PadFind(id string) (bool, int) {
+	first := 0
+	for _, group := range community.Groups {
+		if valid, index := group.PadIndexGet(id); valid {
+			return true, first + index
+		}
+		first += group.PadNumGet()
+	}
+	return false, 0
+}
+
+// PadIndexGet - returns the index of the pad within the community that is
+// calculated from the offset of its configuration registers
+// offset : offset of the DW0 register relative to the community base address
+// return
+//     bool : false if the offset is not aligned to the pad configuration registers
+//     int  : pad index
+func (community Community) PadIndexGet(offset uint16) (bool, int) {
+	if community.Stride == 0 || offset < community.Base ||
+			(offset - community.Base) % community.Stride != 0 {
+		return false, 0
+	}
+	return true, int((offset - community.Base) / community.Stride)
+}
+
+// PadNameGet - returns the name of the pad with the index within the community
+// index : pad index
+// return
+//     bool   : false if there is no pad with this index
+//     string : pad name, e.g. GPP_A1, empty for the reserved pads
+func (community Community) PadNameGet(index int) (bool, string) {
+	for _, group := range community.Groups {
+		if index < group.PadNumGet() {
+			if group.Name == "" {
+				return true, ""
+			}
+			return true, group.PadNameGet(index)
+		}
+		index -= group.PadNumGet()
+	}
+	return false, ""
+}
+
+// HostSwOwnBitGet - returns the position of the pad in the HOSTSW_OWN registers
+// of the community. Each group starts from a new register.
+// index : pad index within the community
+// return
+//     int : register number
+//     int : bit number
+func (community Community) HostSwOwnBitGet(index int) (int, int) {
+	register := 0
+	for _, group := range community.Groups {
+		if index < group.PadNumGet() {
+			break
+		}
+		index -= group.PadNumGet()
+		register += (group.PadNumGet() + 31) / 32
+	}
+	return register + index / 32, index % 32
+}
+
+// PinGet - returns the number of the pad in the Linux pinctrl numbering
+// index : pad index within the community
+// return
+//     bool : false if the community is not exposed by the Linux driver
+//     int  : pin number
+func (community Community) PinGet(index int) (bool, int) {
+	if community.PinBase < 0 {
+		return false, 0
+	}
+	return true, community.PinBase + index
+}
+
+// GpioGet - returns the Linux gpiochip offset of the pad
+// index : pad index within the community
+// return
+//     bool : false if the pad is not mapped to the gpiochip
+//     int  : gpiochip offset
+func (community Community) GpioGet(index int) (bool, int) {
+	valid, pin := community.PinGet(index)
+	if !valid {
+		return false, 0
+	}
+	first := 0
+	for _, group := range community.Groups {
+		if index < first + group.PadNumGet() {
+			switch group.GpioBase {
+			case GpioBaseMatch:
+				return true, pin
+			case GpioBaseNoMap:
+				return false, 0
+			case GpioBaseZero:
+				return true, index - first
+			}
+			return true, group.GpioBase + index - first
+		}
+		first += group.PadNumGet()
+	}
+	// the communities without groups are mapped as is
+	return true, pin
+}
+
+// GroupNameExtract - returns the identifier of the group with the numbered pads
+// that is contained in the line. The groups without the numbered pads are not
+// extracted, since their names are not unique, e.g. SPI0_CLK and SPI.
+// communities : community descriptors of the platform
+// line        : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func GroupNameExtract(communities []Community, line string) (bool, string) {
+	// the longest name wins, since the name of one group can contain the name
+	// of another one (GPP_V and GPP_VGPIO_, GPP_A and IOE_GPP_A)
+	name := ""
+	for _, community := range communities {
+		for _, group := range community.Groups {
+			if group.Name != "" && group.Size != 0 && strings.Contains(line, group.Name) &&
+					len(group.Name) > len(name) {
+				name = group.Name
+			}
+		}
+	}
+	return name != "", name
+}
+
+// KeywordCheck - returns true if the line contains the name of a pad from the
+// community descriptors
+// communities : community descriptors of the platform
+// line        : string from the configuration file
+func KeywordCheck(communities []Community, line string) bool {
+	if valid, _ := GroupNameExtract(communities, line); valid {
+		return true
+	}
+	for _, community := range communities {
+		for _, group := range community.Groups {
+			for _, name := range group.Pads {
+				if strings.Contains(line, name) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -1,13 +1,13 @@
 package common
 
+import "fmt"
 import "strconv"
 import "sync"
 
 import "../../config"
 
 type Fields interface {
-	DecodeDW0()
-	DecodeDW1()
+	DecodeDW(number uint8)
 	GenerateString()
 }
 
@@ -16,6 +16,22 @@ const (
 	PAD_OWN_DRIVER = 1
 )
 
+// Pad configuration lock state from the PADCFGLOCK and PADCFGLOCKTX registers
+const (
+	PAD_UNLOCK       = 0
+	PAD_LOCK_CONFIG  = 1 << 0
+	PAD_LOCK_TX      = 1 << 1
+	PAD_LOCK_FULL    = PAD_LOCK_CONFIG | PAD_LOCK_TX
+	PAD_LOCK_UNKNOWN = 1 << 2
+)
+
+// Driver-mode interrupt state from the GPI_IE register
+const (
+	PAD_INT_DISABLE = 0
+	PAD_INT_ENABLE  = 1
+	PAD_INT_UNKNOWN = 2
+)
+
 const (
 	TxLASTRxE     = 0x0
 	Tx0RxDCRx0    = 0x1
@@ -72,6 +88,10 @@ type Macro struct {
 	padID     string
 	str       string
 	ownership uint8
+	lock      uint8
+	interrupt uint8
+	infolevel uint8
+	dwnum     uint8
 	Fields
 }
 
@@ -108,6 +128,60 @@ func (macro *Macro) IsOwnershipDriver() bool {
 	return macro.ownership == PAD_OWN_DRIVER
 }
 
+func (macro *Macro) SetPadLock(lock uint8) *Macro {
+	macro.lock = lock
+	return macro
+}
+
+func (macro *Macro) PadLockGet() uint8 {
+	return macro.lock
+}
+
+func (macro *Macro) SetPadInterrupt(interrupt uint8) *Macro {
+	macro.interrupt = interrupt
+	return macro
+}
+
+func (macro *Macro) PadInterruptGet() uint8 {
+	return macro.interrupt
+}
+
+// SetInfoLevel - sets the info level of the comments in the generated macro,
+// it is passed with the pad, so the macro can be generated with another level
+// than the one of the output file
+func (macro *Macro) SetInfoLevel(level uint8) *Macro {
+	macro.infolevel = level
+	return macro
+}
+
+func (macro *Macro) InfoLevelGet() uint8 {
+	return macro.infolevel
+}
+
+// RegistersSet - sets the values of the pad configuration registers and their
+// read-only fields masks, clears the control masks
+// dw : register values
+// ro : read-only fields masks, the number of masks is the number of the pad
+//      configuration registers on the platform
+func (macro *Macro) RegistersSet(dw [MAX_DW_NUM]uint32, ro []uint32) *Macro {
+	macro.dwnum = uint8(len(ro))
+	for i := range macro.Reg {
+		macro.Reg[i].CntrMaskFieldsClear(AllFields)
+		if i < len(ro) {
+			macro.Reg[i].ValueSet(dw[i]).ReadOnlyFieldsSet(ro[i])
+		} else {
+			// the register is not implemented on the platform
+			macro.Reg[i].ValueSet(0).ReadOnlyFieldsSet(^uint32(0))
+		}
+	}
+	return macro
+}
+
+// DwNumGet - returns the number of the pad configuration registers on the platform
+func (macro *Macro) DwNumGet() uint8 {
+	return macro.dwnum
+}
+
 // returns <Register> data configuration structure
 // number : register number
 func (macro *Macro) Register(number uint8) *Register {
@@ -137,6 +211,19 @@ func (macro *Macro) Clear() *Macro {
 	return macro
 }
 
+// Field - returns the string of the single macro argument without changing the
+// current macro string
+// argument : method that adds the argument to the macro, e.g. macro.Pull
+// return: argument string
+func (macro *Macro) Field(argument func() *Macro) string {
+	str := macro.Get()
+	macro.Set("(")
+	argument()
+	field := macro.Get()[1:]
+	macro.Set(str)
+	return field
+}
+
 // Adds PAD Id to the macro as a new argument
 // return: Macro
 func (macro *Macro) Id() *Macro {
@@ -275,9 +362,41 @@ func (macro *Macro) IOTerm() *Macro {
 	return macro.Separator().Add(ioTermMacro[dw1.GetIOStandbyTermination()])
 }
 
-// Check created macro
+// Adds Debounce Duration from DW2 to the macro as a new argument
+// return: Macro
+func (macro *Macro) Debounce() *Macro {
+	var debounce = map[uint8]string{
+		0x3: "DEBOUNCE_8_RTC",
+		0x4: "DEBOUNCE_16_RTC",
+		0x5: "DEBOUNCE_32_RTC",
+		0x6: "DEBOUNCE_64_RTC",
+		0x7: "DEBOUNCE_128_RTC",
+		0x8: "DEBOUNCE_256_RTC",
+		0x9: "DEBOUNCE_512_RTC",
+		0xa: "DEBOUNCE_1K_RTC",
+		0xb: "DEBOUNCE_2K_RTC",
+		0xc: "DEBOUNCE_4K_RTC",
+		0xd: "DEBOUNCE_8K_RTC",
+		0xe: "DEBOUNCE_16K_RTC",
+		0xf: "DEBOUNCE_32K_RTC",
+	}
+	str, valid := debounce[macro.Register(PAD_CFG_DW2).GetDebounce()]
+	if !valid {
+		str = "INVALID"
+	}
+	return macro.Separator().Add(str)
+}
+
+// IsDebounceEnabled - returns true if the platform has the DW2 register and the
+// debounce filter is enabled
+func (macro *Macro) IsDebounceEnabled() bool {
+	return macro.DwNumGet() > PAD_CFG_DW2 && macro.Register(PAD_CFG_DW2).GetDebounceEnable() != 0
+}
+
+// Check created macro. The fields of DW1 are not checked, since many macros set
+// them implicitly
 func (macro *Macro) check() *Macro {
-	if !macro.Register(PAD_CFG_DW0).MaskCheck() {
+	if !macro.Register(PAD_CFG_DW0).MaskCheck() || !macro.Register(PAD_CFG_DW2).MaskCheck() {
 		return macro.GenerateFields()
 	}
 	return macro
@@ -286,7 +405,7 @@ func (macro *Macro) check() *Macro {
 // or - Set " | " if its needed
 func (macro *Macro) Or() *Macro {
 
-		if str := macro.Get(); str[len(str) - 1] == ')' {
+		if str := macro.Get(); str[len(str) - 1] != ' ' && str[len(str) - 1] != '(' {
 			macro.Add(" | ")
 		}
 		return macro
@@ -295,45 +414,35 @@ func (macro *Macro) Or() *Macro {
 // AddToMacroIgnoredMask - Print info about ignored field mask
 // title - warning message
 func (macro *Macro) AddToMacroIgnoredMask() *Macro {
-	if config.InfoLevelGet() < 4 || config.IsFspStyleMacro() {
+	if macro.infolevel < 4 || config.IsFspStyleMacro() {
 		return macro
 	}
-	dw0 := macro.Register(PAD_CFG_DW0)
-	dw1 := macro.Register(PAD_CFG_DW1)
-	// Get mask of ignored bit fields.
-	dw0Ignored := dw0.IgnoredFieldsGet()
-	dw1Ignored := dw1.IgnoredFieldsGet()
-	if dw0Ignored != 0 {
-		dw0temp := dw0.ValueGet()
-		dw0.ValueSet(dw0Ignored)
-		macro.Add("\n\t/* DW0 : ")
-		macro.Fields.DecodeDW0()
-		macro.Add(" - IGNORED */")
-		dw0.ValueSet(dw0temp)
-	}
-	if dw1Ignored != 0 {
-		dw1temp	:= dw1.ValueGet()
-		dw1.ValueSet(dw1Ignored)
-		macro.Add("\n\t/* DW1 : ")
-		macro.Fields.DecodeDW1()
-		macro.Add(" - IGNORED */")
-		dw1.ValueSet(dw1temp)
+	for number := uint8(0); number < macro.DwNumGet(); number++ {
+		dw := macro.Register(number)
+		// Get mask of ignored bit fields.
+		if ignored := dw.IgnoredFieldsGet(); ignored != 0 {
+			temp := dw.ValueGet()
+			dw.ValueSet(ignored)
+			macro.Add("\n\t/* DW" + strconv.Itoa(int(number)) + " : ")
+			macro.Fields.DecodeDW(number)
+			macro.Add(" - IGNORED */")
+			dw.ValueSet(temp)
+		}
 	}
 	return macro
 }
 
 // GenerateFields - generate bitfield macros
 func (macro *Macro) GenerateFields() *Macro {
-	dw0 := macro.Register(PAD_CFG_DW0)
-	dw1 := macro.Register(PAD_CFG_DW1)
-
 	// Get mask of ignored bit fields.
-	dw0Ignored := dw0.IgnoredFieldsGet()
-	dw1Ignored := dw1.IgnoredFieldsGet()
+	var ignored [MAX_DW_NUM]uint32
+	for number := range ignored {
+		ignored[number] = macro.Register(uint8(number)).IgnoredFieldsGet()
+	}
 
-	if config.InfoLevelGet() <= 1 {
+	if macro.infolevel <= 1 {
 		macro.Clear()
-	} else if config.InfoLevelGet() >= 3 {
+	} else if macro.infolevel >= 3 {
 		// Add string of reference macro as a comment
 		reference := macro.Get()
 		macro.Clear()
@@ -344,11 +453,10 @@ func (macro *Macro) GenerateFields() *Macro {
 	if config.AreFieldsIgnored() {
 		// Consider bit fields that should be ignored when regenerating
 		// advansed macros
-		var tempVal uint32 = dw0.ValueGet() & ^dw0Ignored
-		dw0.ValueSet(tempVal)
-
-		tempVal = dw1.ValueGet() & ^dw1Ignored
-		dw1.ValueSet(tempVal)
+		for number := range ignored {
+			dw := macro.Register(uint8(number))
+			dw.ValueSet(dw.ValueGet() & ^ignored[number])
+		}
 	}
 
 	macro.Fields.GenerateString()
@@ -377,6 +485,63 @@ const (
 	txDisable uint8 = 0x1
 )
 
+// ResetRemap - remaps the Pad Reset Source Config (PADRSTCFG) from the inteltool
+// dump to the logical reset used by coreboot
+// rstmap : logical reset for each PADRSTCFG value, nil if the remapping is not
+//          required
+func (macro *Macro) ResetRemap(rstmap []uint8) {
+	if config.TemplateGet() != config.TempInteltool || rstmap == nil {
+		// Use reset source remapping only if the input file is inteltool.log dump
+		return
+	}
+	dw0 := macro.Register(PAD_CFG_DW0)
+	if resetsrc := dw0.GetResetConfig(); int(resetsrc) < len(rstmap) {
+		dw0.ValueSet((dw0.ValueGet() & ^PadRstCfgMask) |
+				uint32(rstmap[resetsrc]) << PadRstCfgShift)
+	} else {
+		fmt.Println("Invalid Pad Reset Config [ 0x", resetsrc, " ] for ", macro.PadIdGet())
+	}
+	dw0.CntrMaskFieldsClear(PadRstCfgMask)
+}
+
+// GenerateVirtual - generates the macro for the virtual GPIO. The virtual pads
+// have no electrical properties, so the input is configured with PAD_CFG_GPI()
+// and the unused pad with PAD_NC(), the termination and the reset are taken from
+// the registers. If the pad is inverted, routed or triggered, or PAD_NC() can not
+// express its reset, the macro is generated as for the other pads. The native
+// functions of the virtual pads (CNVi UART, I2S and the ISH UART bridges) are set
+// up by FSP and are only left as comments.
+// return: string of macro
+func (macro *Macro) GenerateVirtual() string {
+	dw0 := macro.Register(PAD_CFG_DW0)
+	if dw0.GetPadMode() != 0 {
+		return fmt.Sprintf("/* %s - virtual GPIO, NF%d is configured by FSP */",
+				macro.PadIdGet(), dw0.GetPadMode())
+	}
+	status := dw0.GetGPIORxTxDisableStatus()
+	if (status != txDisable && status != rxDisable | txDisable) ||
+			dw0.GetRxInvert() != 0 || dw0.GetRXLevelEdgeConfiguration() != TRIG_OFF ||
+			dw0.GetRXPadStateSelect() != 0 || dw0.GetRXRawOverrideStatus() != 0 ||
+			dw0.GetGPIOInputRouteIOxAPIC() != 0 || dw0.GetGPIOInputRouteSCI() != 0 ||
+			dw0.GetGPIOInputRouteSMI() != 0 || dw0.GetGPIOInputRouteNMI() != 0 {
+		return macro.Generate()
+	}
+	value := dw0.ValueGet()
+	macro.Platform.RemmapRstSrc()
+	if status == txDisable {
+		// PAD_CFG_GPI(pad, pull, rst)
+		macro.Set("PAD_CFG_GPI").Add("(").Id().Pull().Rstsrc().Add("),")
+	} else if dw0.GetResetConfig() == 1 { // 1 = RST_DEEP
+		// PAD_NC(pad, pull)
+		macro.Set("PAD_NC").Add("(").Id().Pull().Add("),")
+	} else {
+		// Generate() remaps the reset source again
+		dw0.ValueSet(value)
+		return macro.Generate()
+	}
+	return macro.checkedGet()
+}
+
 // Gets base string of current macro
 // return: string of macro
 func (macro *Macro) Generate() string {
@@ -402,16 +567,34 @@ func (macro *Macro) Generate() string {
 	} else {
 		macro.Platform.NativeFunctionMacroAdd()
 	}
+	return macro.checkedGet()
+}
+
+// checkedGet - checks the generated macro against the register values and
+// returns the string of the macro in the spelling of the selected coreboot
+// release. The bit fields are generated instead of the macro if it is not
+// available in the release, does not match the registers or the -fld cb
+// option is used
+// return: string of macro
+func (macro *Macro) checkedGet() string {
+	dw0 := macro.Register(PAD_CFG_DW0)
+	vocabulary := VocabularyGet()
+	if !vocabulary.IsAvailable(macro.Get()) {
+		// The macro is not available in the selected coreboot release, clear
+		// the control mask so that the check fails and "Advanced" macro is
+		// generated
+		dw0.CntrMaskFieldsClear(AllFields)
+	}
 
 	if config.IsFieldsMacroUsed() {
 		// Clear control mask to generate advanced macro only
-		return macro.GenerateFields().Get()
+		return vocabulary.Translate(macro.GenerateFields().Get())
 	}
 
 	if config.IsNonCheckingFlagUsed() {
 		macro.AddToMacroIgnoredMask()
-		return macro.Get()
+		return vocabulary.Translate(macro.Get())
 	}
 
-	return macro.check().Get()
+	return vocabulary.Translate(macro.check().Get())
 }
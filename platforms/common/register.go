@@ -50,17 +50,21 @@ const (
 const (
 	PAD_CFG_DW0 = 0
 	PAD_CFG_DW1 = 1
-	MAX_DW_NUM  = 2
+	PAD_CFG_DW2 = 2
+	PAD_CFG_DW3 = 3
+	MAX_DW_NUM  = 4
 )
 
 // Register - configuration data structure based on DW0/1 dw value
 // value    : register value
 // mask     : bit fileds mask
 // roFileds : read only fields mask
+// modeMask : PMODE field mask, PadModeMask if not set
 type Register struct {
 	value    uint32
 	mask     uint32
 	roFileds uint32
+	modeMask uint32
 }
 
 func (reg *Register) ValueSet(value uint32) *Register {
@@ -81,6 +85,21 @@ func (reg *Register) ReadOnlyFieldsGet() uint32 {
 	return reg.roFileds
 }
 
+// PadModeMaskSet - sets the PMODE field mask, if the field is wider than
+// PadModeMask on the platform
+func (reg *Register) PadModeMaskSet(mask uint32) *Register {
+	reg.modeMask = mask
+	return reg
+}
+
+// PadModeMaskGet - returns the PMODE field mask of the platform
+func (reg *Register) PadModeMaskGet() uint32 {
+	if reg.modeMask == 0 {
+		return PadModeMask
+	}
+	return reg.modeMask
+}
+
 // Check the mask of the new macro
 // Returns true if the macro is generated correctly
 func (reg *Register) MaskCheck() bool {
@@ -181,7 +200,7 @@ func (reg *Register) GetGPIOInputRouteNMI() uint8 {
 // 3h = native function 3, if applicable, controls the Pad
 // 4h = enable GPIO blink/PWM capability if applicable
 func (reg *Register) GetPadMode() uint8 {
-	return reg.getFieldVal(PadModeMask, PadModeShift)
+	return reg.getFieldVal(reg.PadModeMaskGet(), PadModeShift)
 }
 
 // getGPIORxTxDisableStatus - returns GPIO RX/TX buffer state (GPIORXDIS | GPIOTXDIS)
@@ -260,3 +279,24 @@ func (reg *Register) GetTermination() uint8 {
 func (reg *Register) GetInterruptSelect() uint8 {
 	return reg.getFieldVal(InterruptSelectMask, 0)
 }
+
+// Bit field constants for PAD_CFG_DW2 register
+const (
+	DebounceShift uint8  = 1
+	DebounceMask  uint32 = 0xF << DebounceShift
+
+	DebounceEnableMask uint32 = 0x1
+)
+
+// GetDebounceEnable - returns Debounce Enable (DEBEN)
+// 1 - debounce filter is enabled, 0 - disabled
+func (reg *Register) GetDebounceEnable() uint8 {
+	return reg.getFieldVal(DebounceEnableMask, 0)
+}
+
+// GetDebounce - returns Debounce Duration (DBNC)
+// Debounce Duration = (2 ^ DBNC) * glitch filter clock (RTC) period,
+// values 0 - 2 are reserved
+func (reg *Register) GetDebounce() uint8 {
+	return reg.getFieldVal(DebounceMask, DebounceShift)
+}
@@ -0,0 +1,14 @@
+package common
+
+// SocTable - table of struct soc_gpio_map for the GPIO community of the SoC
+// without the PAD_CFG registers (Bay Trail, Braswell)
+// Community : community name from the "GPIO Community" header of the dump
+// Name      : name of the table in gpio.c
+// Member    : member of struct soc_gpio_config with the table
+// Size      : number of pads in the community, 0 if it is not checked
+type SocTable struct {
+	Community string
+	Name      string
+	Member    string
+	Size      int
+}
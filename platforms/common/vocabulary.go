@@ -0,0 +1,215 @@
+package common
+
+import "strconv"
+import "strings"
+import "unicode"
+
+import "../../config"
+
+// MacroSpelling - macro name and order of arguments in the coreboot release
+// name  : macro name, empty if the macro is not available in the release
+// order : indexes of the generated arguments in the order of the release,
+//         nil if the order is the same, e.g. {0, 1, 2, 4, 5, 3} moves the
+//         function of PAD_CFG_NF_IOSSTATE_IOSTERM(pad, pull, rst, func,
+//         iosstate, iosterm) to the end
+type MacroSpelling struct {
+	name  string
+	order []int
+}
+
+// Vocabulary - macro vocabulary of the coreboot release. The releases differ in
+// the macro names, the order and the spelling of the arguments
+// macros : macro spelling, the key can include the number of arguments after
+//          the slash to distinguish the short forms: PAD_CFG_GPI_APIC/3
+// enums  : spelling of the macro arguments
+type Vocabulary struct {
+	macros map[string]MacroSpelling
+	enums  map[string]string
+}
+
+// Skylake used its own gpio_defs.h before moving to the common GPIO block, so
+// the termination values were spelled as 20K_PU, 5K_PD, etc.
+var legacyPullSpelling = map[string]string{
+	"DN_5K"  : "5K_PD",
+	"DN_20K" : "20K_PD",
+	"UP_1K"  : "1K_PU",
+	"UP_2K"  : "2K_PU",
+	"UP_5K"  : "5K_PU",
+	"UP_20K" : "20K_PU",
+	"UP_667" : "667_PU",
+}
+
+// See src/soc/intel/common/block/include/intelblocks/gpio_defs.h
+var commonPullSpelling = map[string]string{
+	"5K_PD"  : "DN_5K",
+	"20K_PD" : "DN_20K",
+	"1K_PU"  : "UP_1K",
+	"2K_PU"  : "UP_2K",
+	"5K_PU"  : "UP_5K",
+	"20K_PU" : "UP_20K",
+	"667_PU" : "UP_667",
+}
+
+var vocabularies = map[string]Vocabulary{
+	// src/soc/intel/skylake/include/soc/gpio_defs.h
+	"4.7": {
+		macros : map[string]MacroSpelling{
+			"PAD_CFG_GPI_APIC_LOW"          : {name: "PAD_CFG_GPI_APIC_INVERT"},
+			"PAD_CFG_GPI_APIC_HIGH"         : {name: "PAD_CFG_GPI_APIC"},
+			"PAD_CFG_GPI_APIC/5"            : {name: ""},
+			"PAD_CFG_GPI_TRIG_OWN"          : {name: ""},
+			"PAD_CFG_GPI_TRIG_IOSSTATE_OWN" : {name: ""},
+			"PAD_CFG_GPI_TRIG_IOS_OWN"      : {name: ""},
+			"PAD_CFG_GPI_INT"               : {name: ""},
+			"PAD_CFG_GPO_GPIO_DRIVER"       : {name: ""},
+			"PAD_CFG_GPI_DUAL_ROUTE"        : {name: ""},
+			"PAD_CFG_GPI_APIC_IOS"          : {name: ""},
+			"PAD_CFG_GPIO_BIDIRECT_IOS"     : {name: ""},
+			"PAD_CFG_NF_IOSTANDBY_IGNORE"   : {name: ""},
+		},
+		enums : legacyPullSpelling,
+	},
+	// The common GPIO block with PAD_CFG_GPI_APIC_INVERT
+	"4.11": {
+		macros : map[string]MacroSpelling{
+			"PAD_CFG_GPI_APIC_LOW"  : {name: "PAD_CFG_GPI_APIC_INVERT"},
+			"PAD_CFG_GPI_APIC_HIGH" : {name: "PAD_CFG_GPI_APIC"},
+		},
+		enums : commonPullSpelling,
+	},
+	// PAD_CFG_GPI_APIC_INVERT was replaced with PAD_CFG_GPI_APIC_LOW and the short
+	// form of PAD_CFG_GPI_APIC with PAD_CFG_GPI_APIC_HIGH
+	"4.12": {
+		macros : map[string]MacroSpelling{
+			"PAD_CFG_GPI_APIC_INVERT" : {name: "PAD_CFG_GPI_APIC_LOW"},
+			"PAD_CFG_GPI_APIC/3"      : {name: "PAD_CFG_GPI_APIC_HIGH"},
+		},
+		enums : commonPullSpelling,
+	},
+}
+
+// VocabularyGet - returns the macro vocabulary of the coreboot release selected
+// in the configuration or nil if the native spelling of the platform is used
+func VocabularyGet() *Vocabulary {
+	if config.IsFspStyleMacro() {
+		// FSP-style bit fields are not coreboot macros
+		return nil
+	}
+	if vocabulary, valid := vocabularies[config.CorebootVersionGet()]; valid {
+		return &vocabulary
+	}
+	return nil
+}
+
+// identifierCheck - returns true if the character can be a part of the
+// identifier or the macro argument such as 20K_PU
+func identifierCheck(c byte) bool {
+	return c == '_' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+// spelling - returns the spelling of the macro in the release
+// name : macro name
+// argc : number of arguments
+func (vocabulary *Vocabulary) spelling(name string, argc int) (MacroSpelling, bool) {
+	if spelling, valid := vocabulary.macros[name+"/"+strconv.Itoa(argc)]; valid {
+		return spelling, true
+	}
+	spelling, valid := vocabulary.macros[name]
+	return spelling, valid
+}
+
+// IsAvailable - returns false if the macro is not available in the release
+// str : string of the generated macro
+func (vocabulary *Vocabulary) IsAvailable(str string) bool {
+	if vocabulary == nil {
+		return true
+	}
+	open := strings.Index(str, "(")
+	if open < 0 {
+		return true
+	}
+	args, _ := SplitArguments(str[open+1:])
+	argc := len(args)
+	spelling, valid := vocabulary.spelling(strings.TrimSpace(str[:open]), argc)
+	return !valid || spelling.name != ""
+}
+
+// SplitArguments - splits the string with the macro arguments by the commas at
+// the top nesting level. The arguments are not trimmed, so joined with the commas
+// they give the original string up to the closing parenthesis
+// str    : string after the opening parenthesis of the macro
+// return
+//     list of the arguments
+//     false if the closing parenthesis was not found
+func SplitArguments(str string) ([]string, bool) {
+	var args []string
+	level, start := 0, 0
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '(':
+			level++
+		case ')':
+			if level == 0 {
+				return append(args, str[start:i]), true
+			}
+			level--
+		case ',':
+			if level == 0 {
+				args = append(args, str[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(args, str[start:]), false
+}
+
+// Translate - translates the macro string to the vocabulary of the release
+// str : string of the generated macro, including comments
+// return: translated string
+func (vocabulary *Vocabulary) Translate(str string) string {
+	if vocabulary == nil {
+		return str
+	}
+	var result strings.Builder
+	for i := 0; i < len(str); {
+		if !identifierCheck(str[i]) {
+			result.WriteByte(str[i])
+			i++
+			continue
+		}
+		start := i
+		for i < len(str) && identifierCheck(str[i]) {
+			i++
+		}
+		word := str[start:i]
+		if i < len(str) && str[i] == '(' {
+			args, closed := SplitArguments(str[i+1:])
+			if !closed {
+				result.WriteString(word)
+				continue
+			}
+			length := len(strings.Join(args, ","))
+			if spelling, valid := vocabulary.spelling(word, len(args)); valid && spelling.name != "" {
+				word = spelling.name
+				if len(spelling.order) == len(args) {
+					ordered := make([]string, len(args))
+					for n, index := range spelling.order {
+						ordered[n] = args[index]
+					}
+					args = ordered
+				}
+			}
+			for n := range args {
+				args[n] = vocabulary.Translate(args[n])
+			}
+			result.WriteString(word + "(" + strings.Join(args, ",") + ")")
+			i += 1 + length + 1
+			continue
+		}
+		if spelling, valid := vocabulary.enums[word]; valid {
+			word = spelling
+		}
+		result.WriteString(word)
+	}
+	return result.String()
+}
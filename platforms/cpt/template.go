@@ -0,0 +1,65 @@
+package cpt
+
+// Bank - GPIO registers of the set of pads in the I/O space of GPIOBASE. The
+// registers contain one bit for each pad of the set, the empty name means that
+// the set does not have this register.
+// Set       : number of the set in struct pch_gpio_map
+// Size      : number of pads in the set
+// Mode      : GPIO_USE_SEL, 1 - GPIO, 0 - native function
+// Direction : GP_IO_SEL, 1 - input, 0 - output
+// Level     : GP_LVL, 1 - high, 0 - low
+// Reset     : GP_RST_SEL, 1 - RSMRST#, 0 - PWROK
+// Invert    : GPI_INV, 1 - inverted input
+// Blink     : GPO_BLINK, 1 - blinking output
+type Bank struct {
+	Set       int
+	Size      int
+	Mode      string
+	Direction string
+	Level     string
+	Reset     string
+	Invert    string
+	Blink     string
+}
+
+// The Lynx Point-LP and Wildcat Point-LP PCHs (8/9-series LP) have the per-pad
+// LP_GPIO configuration registers with the ownership in GPIO_OWN1-3 instead of
+// the GPIO sets, see struct pch_lp_gpio_map in
+// src/southbridge/intel/lynxpoint/lp_gpio.h. These PCHs are not decoded.
+const LpOwnership = "GPIO_OWN1"
+
+// GPIO sets of the 6/7-series PCH (Cougar Point, Panther Point) and the 8/9-series
+// desktop/mobile PCH (Lynx Point, Wildcat Point), see src/southbridge/intel/common/gpio.h
+var banks = []Bank{
+	{
+		Set:       1,
+		Size:      32,
+		Mode:      "GPIO_USE_SEL",
+		Direction: "GP_IO_SEL",
+		Level:     "GP_LVL",
+		Reset:     "GP_RST_SEL1",
+		Invert:    "GPI_INV",
+		Blink:     "GPO_BLINK",
+	},
+	{
+		Set:       2,
+		Size:      32,
+		Mode:      "GPIO_USE_SEL2",
+		Direction: "GP_IO_SEL2",
+		Level:     "GP_LVL2",
+		Reset:     "GP_RST_SEL2",
+	},
+	{
+		Set:       3,
+		Size:      12,
+		Mode:      "GPIO_USE_SEL3",
+		Direction: "GP_IO_SEL3",
+		Level:     "GP_LVL3",
+		Reset:     "GP_RST_SEL3",
+	},
+}
+
+// BanksGet - returns the GPIO sets of the PCH
+func BanksGet() []Bank {
+	return banks
+}
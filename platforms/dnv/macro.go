@@ -0,0 +1,265 @@
+package dnv
+
+import "fmt"
+
+// Local packages
+import "../common"
+import "../../config"
+import "../../fields"
+
+const (
+	PAD_CFG_DW0_RO_FIELDS = (0x1 << 27) | (0x1 << 24) | (0x3 << 21) | (0xf << 16) | 0xfc
+	PAD_CFG_DW1_RO_FIELDS = 0xfdffc3ff
+)
+
+const (
+	PAD_CFG_DW0 = common.PAD_CFG_DW0
+	PAD_CFG_DW1 = common.PAD_CFG_DW1
+	MAX_DW_NUM  = common.MAX_DW_NUM
+)
+
+// Reserved value of PADRSTCFG, see RemmapRstSrc()
+const PadRstCfgReserved = 3
+
+type PlatformSpecific struct {}
+
+// RemmapRstSrc - remmap Pad Reset Source Config
+// src/soc/intel/denverton_ns/gpio.c writes PADRSTCFG as is, without the reset
+// map of the community, and src/soc/intel/denverton_ns/include/soc/gpio_defs.h
+// defines only three values:
+//     PAD_CFG0_RESET_PWROK  (0 << 30)
+//     PAD_CFG0_RESET_DEEP   (1 << 30)
+//     PAD_CFG0_RESET_PLTRST (2 << 30)
+// 3 is reserved and has no macro (RSMRST of the common Rstsrc() is not defined
+// for Denverton), so it is replaced with PWROK
+func (PlatformSpecific) RemmapRstSrc() {
+	macro := common.GetMacro()
+	if config.TemplateGet() != config.TempInteltool {
+		// Use reset source remapping only if the input file is inteltool.log dump
+		return
+	}
+	dw0 := macro.Register(PAD_CFG_DW0)
+	if dw0.GetResetConfig() == PadRstCfgReserved {
+		fmt.Printf("Warning: %s: reserved PADRSTCFG 3 is replaced with PWROK!\n",
+				macro.PadIdGet())
+		dw0.ValueSet(dw0.ValueGet() & ^common.PadRstCfgMask)
+	}
+}
+
+// Adds The Pad Termination (TERM) parameter from PAD_CFG_DW1 to the macro
+// as a new argument
+func (PlatformSpecific) Pull() {
+	macro := common.GetMacro()
+	dw1 := macro.Register(PAD_CFG_DW1)
+	// See src/soc/intel/denverton_ns/include/soc/gpio_defs.h
+	var pull = map[uint8]string{
+		0x0: "NONE",
+		0x2: "DN_5K",
+		0x4: "DN_20K",
+		0x9: "UP_1K",
+		0xa: "UP_5K",
+		0xb: "UP_2K",
+		0xc: "UP_20K",
+		0xd: "UP_667",
+		0xf: "NATIVE",
+	}
+	str, valid := pull[dw1.GetTermination()]
+	if !valid {
+		str = "INVALID"
+		fmt.Println("Error",
+				macro.PadIdGet(),
+				" invalid TERM value = ",
+				int(dw1.GetTermination()))
+	}
+	macro.Separator().Add(str)
+}
+
+// Generate macro to cause peripheral IRQ when configured in GPIO input mode
+func ioApicRoute() bool {
+	macro := common.GetMacro()
+	if macro.Register(PAD_CFG_DW0).GetGPIOInputRouteIOxAPIC() == 0 {
+		return false
+	}
+	// e.g. PAD_CFG_GPI_APIC(NORTH_ALL_GPIO_0, NONE, PLTRST, LEVEL, INVERT),
+	macro.Add("_APIC").Add("(").Id().Pull().Rstsrc().Trig().Invert().Add("),")
+	return true
+}
+
+// Generate macro to cause NMI when configured in GPIO input mode
+func nmiRoute() bool {
+	macro := common.GetMacro()
+	if macro.Register(PAD_CFG_DW0).GetGPIOInputRouteNMI() == 0 {
+		return false
+	}
+	// e.g. PAD_CFG_GPI_NMI(SOUTH_GROUP0_GPIO_12, NONE, DEEP, LEVEL, NONE),
+	macro.Add("_NMI").Add("(").Id().Pull().Rstsrc().Trig().Invert().Add("),")
+	return true
+}
+
+// Generate macro to cause SCI when configured in GPIO input mode
+func sciRoute() bool {
+	macro := common.GetMacro()
+	dw0 := macro.Register(PAD_CFG_DW0)
+	if dw0.GetGPIOInputRouteSCI() == 0 {
+		return false
+	}
+	if (dw0.GetRXLevelEdgeConfiguration() & common.TRIG_EDGE_SINGLE) != 0 {
+		// e.g. PAD_CFG_GPI_ACPI_SCI(NORTH_ALL_GPIO_1, NONE, DEEP, YES),
+		macro.Add("_ACPI_SCI").Add("(").Id().Pull().Rstsrc().Invert().Add("),")
+		return true
+	}
+	// e.g. PAD_CFG_GPI_SCI(NORTH_ALL_GPIO_1, UP_20K, PLTRST, LEVEL, INVERT),
+	macro.Add("_SCI").Add("(").Id().Pull().Rstsrc().Trig().Invert().Add("),")
+	return true
+}
+
+// Generate macro to cause SMI when configured in GPIO input mode
+func smiRoute() bool {
+	macro := common.GetMacro()
+	dw0 := macro.Register(PAD_CFG_DW0)
+	if dw0.GetGPIOInputRouteSMI() == 0 {
+		return false
+	}
+	if (dw0.GetRXLevelEdgeConfiguration() & common.TRIG_EDGE_SINGLE) != 0 {
+		// e.g. PAD_CFG_GPI_ACPI_SMI(NORTH_ALL_GPIO_2, NONE, DEEP, YES),
+		macro.Add("_ACPI_SMI").Add("(").Id().Pull().Rstsrc().Invert().Add("),")
+		return true
+	}
+	// e.g. PAD_CFG_GPI_SMI(NORTH_ALL_GPIO_2, NONE, DEEP, LEVEL, NONE),
+	macro.Add("_SMI").Add("(").Id().Pull().Rstsrc().Trig().Invert().Add("),")
+	return true
+}
+
+// Generate macro for GPIO input owned by the GPIO driver, if the GPI_IE register
+// from the dump shows whether the driver has enabled the interrupt. The Denverton
+// dumps usually do not contain GPI_IE, then the macro depends on the trigger
+func driverInterrupt() bool {
+	macro := common.GetMacro()
+	dw0 := macro.Register(PAD_CFG_DW0)
+	if !macro.IsOwnershipDriver() {
+		return false
+	}
+	trig := dw0.GetRXLevelEdgeConfiguration()
+	switch macro.PadInterruptGet() {
+	case common.PAD_INT_ENABLE:
+		if trig == common.TRIG_OFF {
+			return false
+		}
+	case common.PAD_INT_DISABLE:
+		if trig != common.TRIG_OFF {
+			return false
+		}
+	}
+	if trig != common.TRIG_OFF {
+		// e.g. PAD_CFG_GPI_INT(NORTH_ALL_GPIO_0, NONE, PLTRST, EDGE_SINGLE),
+		macro.Add("_INT").Add("(").Id().Pull().Rstsrc().Trig().Add("),")
+		return true
+	}
+	// e.g. PAD_CFG_GPI_GPIO_DRIVER(NORTH_ALL_GPIO_0, NONE, PLTRST),
+	macro.Add("_GPIO_DRIVER").Add("(").Id().Pull().Rstsrc().Add("),")
+	return true
+}
+
+// Adds PAD_CFG_GPI macro with arguments
+func (PlatformSpecific) GpiMacroAdd() {
+	macro := common.GetMacro()
+	var ids []string
+	macro.Set("PAD_CFG_GPI")
+	for routeid, isRoute := range map[string]func() (bool) {
+		"IOAPIC": ioApicRoute,
+		"SCI":    sciRoute,
+		"SMI":    smiRoute,
+		"NMI":    nmiRoute,
+	} {
+		if isRoute() {
+			ids = append(ids, routeid)
+		}
+	}
+
+	switch argc := len(ids); argc {
+	case 0:
+		if driverInterrupt() {
+			break
+		}
+		if !macro.IsOwnershipDriver() {
+			// e.g. PAD_CFG_GPI(NORTH_ALL_GPIO_0, NONE, DEEP),
+			macro.Add("(").Id().Pull().Rstsrc().Add("),")
+			break
+		}
+		// The interrupt state from GPI_IE does not match the trigger, clear the
+		// control mask so that the check fails and "Advanced" macro is generated
+		macro.Register(PAD_CFG_DW0).CntrMaskFieldsClear(common.AllFields)
+	case 1:
+		// GPI with IRQ route
+		if config.AreFieldsIgnored() {
+			// Set Host Software Ownership to ACPI mode
+			macro.SetPadOwnership(common.PAD_OWN_ACPI)
+		}
+	default:
+		// There are no macros with several routes in gpio_defs.h of Denverton,
+		// clear the control mask so that the check fails and "Advanced" macro
+		// is generated
+		macro.Register(PAD_CFG_DW0).CntrMaskFieldsClear(common.AllFields)
+	}
+}
+
+// Adds PAD_CFG_GPO macro with arguments
+func (PlatformSpecific) GpoMacroAdd() {
+	macro := common.GetMacro()
+	dw0 := macro.Register(PAD_CFG_DW0)
+	term := macro.Register(PAD_CFG_DW1).GetTermination()
+	if dw0.GetRXLevelEdgeConfiguration() != common.TRIG_OFF {
+		dw0.CntrMaskFieldsClear(common.RxLevelEdgeConfigurationMask)
+	}
+	macro.Set("PAD_CFG")
+	if term != 0 {
+		// e.g. PAD_CFG_TERM_GPO(SOUTH_GROUP0_GPIO_4, 1, DN_20K, DEEP),
+		macro.Add("_TERM")
+	}
+	// e.g. PAD_CFG_GPO(SOUTH_GROUP0_GPIO_4, 1, DEEP),
+	macro.Add("_GPO").Add("(").Id().Val()
+	if term != 0 {
+		macro.Pull()
+	}
+	macro.Rstsrc().Add("),")
+}
+
+// Adds PAD_CFG_NF macro with arguments
+func (PlatformSpecific) NativeFunctionMacroAdd() {
+	macro := common.GetMacro()
+	// e.g. PAD_CFG_NF(SOUTH_GROUP0_UART0_RXD, NONE, DEEP, NF1),
+	macro.Set("PAD_CFG_NF").Add("(").Id().Pull().Rstsrc().Padfn().Add("),")
+}
+
+// Adds PAD_NC macro
+func (PlatformSpecific) NoConnMacroAdd() {
+	macro := common.GetMacro()
+	// #define PAD_NC(pad, pull)
+	// _PAD_CFG_STRUCT(pad,
+	//     PAD_FUNC(GPIO) | PAD_RESET(DEEP) | PAD_TRIG(OFF) | PAD_BUF(TX_RX_DISABLE),
+	//     PAD_PULL(pull))
+	dw0 := macro.Register(PAD_CFG_DW0)
+	if dw0.GetRXLevelEdgeConfiguration() != common.TRIG_OFF {
+		dw0.CntrMaskFieldsClear(common.RxLevelEdgeConfigurationMask)
+	}
+	if dw0.GetResetConfig() != 1 { // 1 = RST_DEEP
+		dw0.CntrMaskFieldsClear(common.PadRstCfgMask)
+	}
+	macro.Set("PAD_NC").Add("(").Id().Pull().Add("),")
+}
+
+// GenMacro - generate pad macro
+// dw : values of the pad configuration registers
+// lock : pad configuration lock state
+// interrupt : driver-mode interrupt state
+// level : info level of the comments in the macro
+// return: string of macro
+//         error
+func (PlatformSpecific) GenMacro(id string, dw [MAX_DW_NUM]uint32, ownership uint8, lock uint8, interrupt uint8, level uint8) string {
+	macro := common.GetInstanceMacro(PlatformSpecific{}, fields.InterfaceGet())
+	macro.Clear()
+	macro.PadIdSet(id).SetPadOwnership(ownership).SetPadLock(lock).SetPadInterrupt(interrupt).
+			SetInfoLevel(level)
+	macro.RegistersSet(dw, []uint32{PAD_CFG_DW0_RO_FIELDS, PAD_CFG_DW1_RO_FIELDS})
+	return macro.Generate()
+}
@@ -0,0 +1,122 @@
+package dnv
+
+import "strings"
+
+// Local packages
+import "../common"
+
+// pads - returns the coreboot names of the pads of the group, the pad names
+// from the datasheet are prefixed with the group name
+func pads(group string, names ...string) []string {
+	var ids []string
+	for _, name := range names {
+		ids = append(ids, group + "_" + name)
+	}
+	return ids
+}
+
+// See src/soc/intel/denverton_ns/include/soc/gpio_defs.h and
+// drivers/pinctrl/intel/pinctrl-denverton.c in Linux. The pads have no numbered
+// groups, so the pads are listed in the order of their configuration registers.
+var communities = []common.Community{
+	{Name: "N", Base: 0x400, Stride: 8, HostSwOwn: 0xc0, PinBase: 0, Groups: []common.Group{
+		{Name: "NORTH_ALL", Pads: pads("NORTH_ALL",
+			"GBE0_SDP0", "GBE1_SDP0", "GBE0_SDP1", "GBE1_SDP1", "GBE0_SDP2",
+			"GBE1_SDP2", "GBE0_SDP3", "GBE1_SDP3", "GBE2_LED0", "GBE2_LED1",
+			"GBE0_I2C_CLK", "GBE0_I2C_DATA", "GBE1_I2C_CLK", "GBE1_I2C_DATA",
+			"NCSI_RXD0", "NCSI_CLK_IN", "NCSI_RXD1", "NCSI_CRS_DV", "NCSI_ARB_IN",
+			"NCSI_TX_EN", "NCSI_TXD0", "NCSI_TXD1", "NCSI_ARB_OUT", "GBE0_LED0",
+			"GBE0_LED1", "GBE1_LED0", "GBE1_LED1", "GPIO_0", "PCIE_CLKREQ0_N",
+			"PCIE_CLKREQ1_N", "PCIE_CLKREQ2_N", "PCIE_CLKREQ3_N", "PCIE_CLKREQ4_N",
+			"GPIO_1", "GPIO_2", "SVID_ALERT_N", "SVID_DATA", "SVID_CLK",
+			"THERMTRIP_N", "PROCHOT_N", "MEMHOT_N")},
+	}},
+	{Name: "S", Base: 0x400, Stride: 8, HostSwOwn: 0xc0, PinBase: 41, Groups: []common.Group{
+		{Name: "SOUTH_DFX", Pads: pads("SOUTH_DFX",
+			"DFX_PORT_CLK0", "DFX_PORT_CLK1", "DFX_PORT0", "DFX_PORT1", "DFX_PORT2",
+			"DFX_PORT3", "DFX_PORT4", "DFX_PORT5", "DFX_PORT6", "DFX_PORT7",
+			"DFX_PORT8", "DFX_PORT9", "DFX_PORT10", "DFX_PORT11", "DFX_PORT12",
+			"DFX_PORT13", "DFX_PORT14", "DFX_PORT15")},
+		{Name: "SOUTH_GROUP0", Pads: pads("SOUTH_GROUP0",
+			"GPIO_12", "SMB5_GBE_ALRT_N", "PCIE_CLKREQ5_N", "PCIE_CLKREQ6_N",
+			"PCIE_CLKREQ7_N", "UART0_RXD", "UART0_TXD", "SMB5_GBE_CLK",
+			"SMB5_GBE_DATA", "ERROR2_N", "ERROR1_N", "ERROR0_N", "IERR_N", "MCERR_N",
+			"SMB0_LEG_CLK", "SMB0_LEG_DATA", "SMB0_LEG_ALRT_N", "SMB1_HOST_DATA",
+			"SMB1_HOST_CLK", "SMB2_PECI_DATA", "SMB2_PECI_CLK", "SMB4_CSME0_DATA",
+			"SMB4_CSME0_CLK", "SMB4_CSME0_ALRT_N", "USB_OC0_N", "FLEX_CLK_SE0",
+			"FLEX_CLK_SE1", "GPIO_4", "GPIO_5", "GPIO_6", "GPIO_7", "SATA0_LED_N",
+			"SATA1_LED_N", "SATA_PDETECT0", "SATA_PDETECT1", "SATA0_SDOUT",
+			"SATA1_SDOUT", "UART1_RXD", "UART1_TXD", "GPIO_8", "GPIO_9", "TCK",
+			"TRST_N", "TMS", "TDI", "TDO", "CX_PRDY_N", "CX_PREQ_N", "CTBTRIGINOUT",
+			"CTBTRIGOUT", "DFX_SPARE2", "DFX_SPARE3", "DFX_SPARE4")},
+		{Name: "SOUTH_GROUP1", Pads: pads("SOUTH_GROUP1",
+			"SUSPWRDNACK", "PMU_SUSCLK", "ADR_TRIGGER", "PMU_SLP_S45_N",
+			"PMU_SLP_S3_N", "PMU_WAKE_N", "PMU_PWRBTN_N", "PMU_RESETBUTTON_N",
+			"PMU_PLTRST_N", "SUS_STAT_N", "SLP_S0IX_N", "SPI_CS0_N", "SPI_CS1_N",
+			"SPI_MOSI_IO0", "SPI_MISO_IO1", "SPI_IO2", "SPI_IO3", "SPI_CLK",
+			"SPI_CLK_LOOPBK", "ESPI_IO0", "ESPI_IO1", "ESPI_IO2", "ESPI_IO3",
+			"ESPI_CS0_N", "ESPI_CLK", "ESPI_RST_N", "ESPI_ALRT0_N", "GPIO_10",
+			"GPIO_11", "ESPI_CLK_LOOPBK", "EMMC_CMD", "EMMC_STROBE", "EMMC_CLK",
+			"EMMC_D0", "EMMC_D1", "EMMC_D2", "EMMC_D3", "EMMC_D4", "EMMC_D5",
+			"EMMC_D6", "EMMC_D7", "GPIO_3")},
+	}},
+}
+
+// GroupNameExtract - This function extracts the group ID, if it exists in a row
+// line      : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func (PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	// The pads are not numbered, but their names start with the group name
+	for _, community := range communities {
+		for _, group := range community.Groups {
+			if strings.Contains(line, group.Name) {
+				return true, group.Name
+			}
+		}
+	}
+	return false, ""
+}
+
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// See src/soc/intel/denverton_ns/include/soc/gpe.h
+	var groups = map[uint8]string{
+		0x0: "NORTH_ALL",
+		0x1: "SOUTH_DFX",
+		0x2: "SOUTH_GROUP0",
+		0x3: "SOUTH_GROUP1",
+	}
+	group, valid := groups[value]
+	return valid, group
+}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	return communities
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// KeywordCheck - This function is used to filter parsed lines of the configuration file and
+//                returns true if the keyword is contained in the line.
+// line      : string from the configuration file
+func (PlatformSpecific) KeywordCheck(line string) bool {
+	return common.KeywordCheck(communities, line)
+}
@@ -0,0 +1,102 @@
+package ebg
+
+// Local packages
+import "../common"
+
+// Reset mapping of the communities, the same as for Lewisburg without GPD
+var rstMapGpp = []uint8{common.RST_RSMRST, common.RST_DEEP, common.RST_PLTRST}
+
+// See src/soc/intel/xeon_sp/ebg/include/soc/gpio_ebg.h and
+// drivers/pinctrl/intel/pinctrl-emmitsburg.c in Linux. The SPI and JTAG pads are
+// reserved and there is no community 2.
+var communities = []common.Community{
+	{Base: 0x700, Stride: 16, HostSwOwn: 0x130, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_A", Size: 21},
+		{Name: "GPP_B", Size: 24},
+		{Size: 21, GpioBase: common.GpioBaseNoMap},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0x130, ResetMap: rstMapGpp,
+		PinBase: 66, Groups: []common.Group{
+		{Name: "GPP_C", Size: 22},
+		{Name: "GPP_D", Size: 24},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0x130, ResetMap: rstMapGpp, PinBase: -1},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0x130, ResetMap: rstMapGpp,
+		PinBase: 112, Groups: []common.Group{
+		{Name: "GPP_E", Size: 24},
+		{Size: 11, GpioBase: common.GpioBaseNoMap},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0x130, ResetMap: rstMapGpp,
+		PinBase: 147, Groups: []common.Group{
+		{Name: "GPP_H", Size: 19},
+		{Name: "GPP_J", Size: 18},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0x130, ResetMap: rstMapGpp,
+		PinBase: 184, Groups: []common.Group{
+		{Name: "GPP_I", Size: 24},
+		{Name: "GPP_L", Size: 16},
+		{Name: "GPP_M", Size: 18},
+		{Name: "GPP_N", Size: 2},
+	}},
+}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	return communities
+}
+
+// GroupNameExtract - This function extracts the group ID, if it exists in a row
+// line      : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func (platform PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	// The groups differ from Lewisburg, so the group keywords of Sunrise are
+	// not inherited
+	return common.GroupNameExtract(platform.CommunitiesGet(nil), line)
+}
+
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// See src/soc/intel/xeon_sp/ebg/include/soc/gpe.h
+	var groups = map[uint8]string{
+		0x0: "GPP_A",
+		0x1: "GPP_B",
+		0x2: "GPP_C",
+		0x3: "GPP_D",
+		0x4: "GPP_E",
+		0x5: "GPP_H",
+		0x6: "GPP_J",
+		0x7: "GPP_I",
+		0x8: "GPP_L",
+		0x9: "GPP_M",
+		0xa: "GPP_N",
+	}
+	group, valid := groups[value]
+	return valid, group
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// KeywordCheck - This function is used to filter parsed lines of the configuration file and
+//                returns true if the keyword is contained in the line.
+// line      : string from the configuration file
+func (platform PlatformSpecific) KeywordCheck(line string) bool {
+	return common.KeywordCheck(platform.CommunitiesGet(nil), line)
+}
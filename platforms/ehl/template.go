@@ -0,0 +1,117 @@
+package ehl
+
+// Local packages
+import "../common"
+
+// Reset mapping of the GPP communities, the mapping of GPD is the same as the
+// logical one
+var rstMapGpp = []uint8{common.RST_RSMRST, common.RST_DEEP, common.RST_PLTRST}
+
+var hvcmos = []string{"L_BKLTEN", "L_BKLTCTL", "L_VDDEN", "SYS_PWROK", "SYS_RESETB", "MLK_RSTB"}
+
+// See src/soc/intel/elkhartlake/include/soc/gpio_soc_defs.h and
+// drivers/pinctrl/intel/pinctrl-elkhartlake.c in Linux. Each community is a
+// separate GPIO controller in Linux, so the pins of the community are numbered
+// from 0. The CPU pads of the Community 3 are reserved.
+var communities = []common.Community{
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_B", Size: 24, GpioBase: 0,
+				Pads: []string{"GSPI0_CLK_LOOPBK", "GSPI1_CLK_LOOPBK"}},
+		{Name: "GPP_T", Size: 16, GpioBase: 32},
+		{Name: "GPP_G", Size: 24, GpioBase: 64},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_V", Size: 16, GpioBase: 0},
+		{Name: "GPP_H", Size: 24, GpioBase: 32},
+		{Name: "GPP_D", Size: 20, GpioBase: 64, Pads: []string{"GSPI2_CLK_LOOPBK"}},
+		{Name: "GPP_U", Size: 20, GpioBase: 96},
+		{Name: "GPP_VGPIO_", Size: 32, GpioBase: 128, Virtual: true},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, PinBase: -1, Groups: []common.Group{
+		{Name: "GPD", Size: 12},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Size: 17, GpioBase: common.GpioBaseNoMap},
+		{Name: "GPP_S", Size: 2, GpioBase: 32},
+		{Name: "GPP_A", Size: 24, GpioBase: 64, Pads: []string{"ESPI_CLK_LOOPBK"}},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_C", Size: 24, GpioBase: 0},
+		{Name: "GPP_F", Size: 24, GpioBase: 32, Pads: []string{"GPPF_CLK_LOOPBK"}},
+		{Name: "HVCMOS", GpioBase: common.GpioBaseNoMap, Pads: hvcmos},
+		{Name: "GPP_E", Size: 24, GpioBase: 64, Pads: []string{"GPPE_CLK_LOOPBK"}},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_R", Size: 8, GpioBase: 0},
+	}},
+}
+
+// PlatformSpecific - the pad macros are generated by the common GPIO block, see
+// platforms/block/macro.go
+type PlatformSpecific struct {}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	return communities
+}
+
+// GroupNameExtract - This function extracts the group ID, if it exists in a row
+// line      : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func (platform PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	return common.GroupNameExtract(platform.CommunitiesGet(nil), line)
+}
+
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// See src/soc/intel/elkhartlake/include/soc/gpe.h
+	var groups = map[uint8]string{
+		0x0: "GPP_B",
+		0x1: "GPP_T",
+		0x2: "GPP_G",
+		0x3: "GPP_V",
+		0x4: "GPP_H",
+		0x5: "GPP_D",
+		0x6: "GPP_U",
+		0x7: "GPP_S",
+		0x8: "GPP_A",
+		0x9: "GPP_C",
+		0xa: "GPP_F",
+		0xb: "GPP_E",
+		0xc: "GPP_R",
+		0xd: "GPD",
+	}
+	group, valid := groups[value]
+	return valid, group
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// KeywordCheck - This function is used to filter parsed lines of the configuration file and
+//                returns true if the keyword is contained in the line.
+// line      : string from the configuration file
+func (platform PlatformSpecific) KeywordCheck(line string) bool {
+	return common.KeywordCheck(platform.CommunitiesGet(nil), line)
+}
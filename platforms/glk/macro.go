@@ -0,0 +1,58 @@
+package glk
+
+// Local packages
+import "../../fields"
+import "../common"
+import "../apl"
+
+const (
+	PAD_CFG_DW0_RO_FIELDS = apl.PAD_CFG_DW0_RO_FIELDS
+	PAD_CFG_DW1_RO_FIELDS = apl.PAD_CFG_DW1_RO_FIELDS
+)
+
+const (
+	PAD_CFG_DW0 = common.PAD_CFG_DW0
+	PAD_CFG_DW1 = common.PAD_CFG_DW1
+	MAX_DW_NUM  = common.MAX_DW_NUM
+)
+
+type InheritanceMacro interface {
+	Pull()
+	GpiMacroAdd()
+	GpoMacroAdd()
+	NativeFunctionMacroAdd()
+	NoConnMacroAdd()
+}
+
+// PlatformSpecific - Gemini Lake uses the macros of Apollo Lake as is. The I/O
+// standby state (IOSSTATE, DW1 bits 17:14) and the I/O standby termination
+// (IOSTERM, DW1 bits 9:8) have the same position and values on both SoCs, and
+// both use the PAD_CFG_*_IOSSTATE* macros of soc/intel/common/block gpio_defs.h,
+// so the Apollo Lake GpiMacroAdd() and NativeFunctionMacroAdd() are correct
+// for Gemini Lake.
+type PlatformSpecific struct {
+	InheritanceMacro
+}
+
+// RemmapRstSrc - remmap Pad Reset Source Config
+// remmap is not required, the reset mapping is the same as for Apollo Lake
+func (PlatformSpecific) RemmapRstSrc() {}
+
+// GenMacro - generate pad macro
+// dw : values of the pad configuration registers
+// lock : pad configuration lock state
+// interrupt : driver-mode interrupt state
+// level : info level of the comments in the macro
+// return: string of macro
+//         error
+func (platform PlatformSpecific) GenMacro(id string, dw [MAX_DW_NUM]uint32, ownership uint8, lock uint8, interrupt uint8, level uint8) string {
+	// Gemini Lake is a successor of Apollo Lake with the same pad configuration
+	// macros, so we will inherit the platform-dependent functions from Apollo Lake.
+	macro := common.GetInstanceMacro(PlatformSpecific{InheritanceMacro : apl.PlatformSpecific{}},
+			fields.InterfaceGet())
+	macro.Clear()
+	macro.PadIdSet(id).SetPadOwnership(ownership).SetPadLock(lock).SetPadInterrupt(interrupt).
+			SetInfoLevel(level)
+	macro.RegistersSet(dw, []uint32{PAD_CFG_DW0_RO_FIELDS, PAD_CFG_DW1_RO_FIELDS})
+	return macro.Generate()
+}
@@ -0,0 +1,82 @@
+package glk
+
+import "strconv"
+
+// Local packages
+import "../common"
+
+// gpios - returns the names of the numbered pads GPIO_first ... GPIO_last
+func gpios(first int, last int) []string {
+	var names []string
+	for i := first; i <= last; i++ {
+		names = append(names, "GPIO_" + strconv.Itoa(i))
+	}
+	return names
+}
+
+// See src/soc/intel/apollolake/include/soc/gpio_glk.h in coreboot and
+// drivers/pinctrl/intel/pinctrl-geminilake.c in Linux. The pads are numbered
+// across the communities, but some pads at the end of the numbering belong to
+// the NW and SCC communities. As on Apollo Lake, each community is a separate
+// GPIO controller in Linux, so the pins of the community are numbered from 0.
+var communities = []common.Community{
+	{Name: "NW", Base: 0x600, Stride: 16, HostSwOwn: 0xc0, Groups: []common.Group{
+		{Name: "NW", Pads: append(gpios(0, 75), gpios(211, 214)...)},
+	}},
+	{Name: "N", Base: 0x600, Stride: 16, HostSwOwn: 0xc0, Groups: []common.Group{
+		{Name: "N", Pads: gpios(76, 155)},
+	}},
+	{Name: "AUDIO", Base: 0x600, Stride: 16, HostSwOwn: 0xc0, Groups: []common.Group{
+		{Name: "AUDIO", Pads: gpios(156, 175)},
+	}},
+	{Name: "SCC", Base: 0x600, Stride: 16, HostSwOwn: 0xc0, Groups: []common.Group{
+		{Name: "SCC", Pads: gpios(176, 210)},
+	}},
+}
+
+// GroupNameExtract - This function extracts the group ID, if it exists in a row
+// line      : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func (PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	return communities
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// KeywordCheck - This function is used to filter parsed lines of the configuration file and
+//                returns true if the keyword is contained in the line.
+// line      : string from the configuration file
+func (PlatformSpecific) KeywordCheck(line string) bool {
+	// Only the pads from the pad list of Gemini Lake, the keywords of Apollo Lake
+	// are not used
+	return common.KeywordCheck(communities, line)
+}
@@ -1,7 +1,11 @@
 package lbg
 
+// Local packages
+import "../common"
+
 type InheritanceTemplate interface {
 	GroupNameExtract(line string) (bool, string)
+	GpeGroupNameGet(value uint8) (bool, string)
 	KeywordCheck(line string) bool
 }
 
@@ -14,6 +18,53 @@ func (platform PlatformSpecific) GroupNameExtract(line string) (bool, string) {
 	return platform.InheritanceTemplate.GroupNameExtract(line)
 }
 
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (platform PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	return platform.InheritanceTemplate.GpeGroupNameGet(value)
+}
+
+// Lewisburg PCH GPIO communities, see drivers/pinctrl/intel/pinctrl-lewisburg.c
+// in Linux for the pin numbers
+var communities = []common.Community{
+	{Base: 0x400, Stride: 8, PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_A", Size: 24}, {Name: "GPP_B", Size: 24}, {Name: "GPP_F", Size: 24},
+	}},
+	{Base: 0x400, Stride: 8, PinBase: 72, Groups: []common.Group{
+		{Name: "GPP_C", Size: 24}, {Name: "GPP_D", Size: 24}, {Name: "GPP_E", Size: 13},
+	}},
+	{Base: 0x400, Stride: 8, PinBase: -1, Groups: []common.Group{{Name: "GPD", Size: 12}}},
+	{Base: 0x400, Stride: 8, PinBase: 133, Groups: []common.Group{{Name: "GPP_I", Size: 11}}},
+	{Base: 0x400, Stride: 8, PinBase: 144, Groups: []common.Group{
+		{Name: "GPP_J", Size: 24}, {Name: "GPP_K", Size: 11},
+	}},
+	{Base: 0x400, Stride: 8, PinBase: 179, Groups: []common.Group{
+		{Name: "GPP_G", Size: 24}, {Name: "GPP_H", Size: 24}, {Name: "GPP_L", Size: 20},
+	}},
+}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	// Lewisburg has its own community layout, it is not inherited from Sunrise
+	return communities
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported, the pin-mux of Lewisburg differs from Sunrise
+	return false, ""
+}
+
 // KeywordCheck - This function is used to filter parsed lines of the configuration file and
 //                returns true if the keyword is contained in the line.
 // line      : string from the configuration file
@@ -0,0 +1,126 @@
+package mtl
+
+// Local packages
+import "../common"
+
+// Reset mapping of the GPP communities, the mapping of GPD is the same as the
+// logical one
+var rstMapGpp = []uint8{common.RST_RSMRST, common.RST_DEEP, common.RST_PLTRST}
+
+var spi = []string{
+	"SPI0_IO_2", "SPI0_IO_3", "SPI0_MOSI_IO_0", "SPI0_MISO_IO_1", "SPI0_TPM_CSB",
+	"SPI0_FLASH_0_CSB", "SPI0_FLASH_1_CSB", "SPI0_CLK", "SPI0_CLK_LOOPBK",
+}
+
+// See src/soc/intel/meteorlake/include/soc/gpio_soc_defs.h and
+// drivers/pinctrl/intel/pinctrl-meteorlake.c in Linux. The SoC and the IOE dies
+// have their own GPIO controllers with the communities numbered from 0, the IOE
+// pads have the IOE_ prefix in coreboot. The CPU and JTAG pads are reserved.
+var communities = []common.Community{
+	{Die: "soc", Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Size: 15, GpioBase: common.GpioBaseNoMap},
+		{Name: "GPP_V", Size: 24, GpioBase: 32},
+		{Name: "GPP_C", Size: 24, GpioBase: 64},
+	}},
+	{Die: "soc", Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 63, Groups: []common.Group{
+		{Name: "GPP_A", Size: 24, GpioBase: 96, Pads: []string{"ESPI_CLK_LOOPBK"}},
+		{Name: "GPP_E", Size: 24, GpioBase: 128, Pads: []string{"THC0_GSPI_CLK_LOOPBK"}},
+	}},
+	{Die: "soc", Base: 0x700, Stride: 16, HostSwOwn: 0xb0, PinBase: -1,
+		Groups: []common.Group{
+		{Name: "GPD", Size: 12},
+	}},
+	{Die: "soc", Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 113, Groups: []common.Group{
+		{Name: "GPP_H", Size: 24, GpioBase: 160,
+				Pads: []string{"LPI3C1_CLK_LOOPBK", "LPI3C0_CLK_LOOPBK"}},
+		{Name: "GPP_F", Size: 24, GpioBase: 192, Pads: []string{"GPPF_CLK_LOOPBK"}},
+		{Name: "SPI0", GpioBase: common.GpioBaseNoMap, Pads: spi},
+		{Name: "GPP_VGPIO3_", Size: 14, GpioBase: 256, Virtual: true},
+	}},
+	{Die: "soc", Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 187, Groups: []common.Group{
+		{Name: "GPP_S", Size: 8, GpioBase: 288},
+		{Size: 10, GpioBase: common.GpioBaseNoMap},
+	}},
+	{Die: "soc", Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 205, Groups: []common.Group{
+		{Name: "GPP_B", Size: 24, GpioBase: 320, Pads: []string{"ISHI3C0_CLK_LOOPBK"}},
+		{Name: "GPP_D", Size: 24, GpioBase: 352, Pads: []string{"GPPD_CLK_LOOPBK"}},
+		{Name: "GPP_VGPIO_", Size: 34, GpioBase: 384, Virtual: true},
+	}},
+	{Die: "ioe", Prefix: "IOE_", Base: 0x700, Stride: 16, HostSwOwn: 0xb0,
+		ResetMap: rstMapGpp, PinBase: -1, Groups: []common.Group{
+		{Name: "IOE_GPP_A", Size: 24, GpioBase: common.GpioBaseNoMap},
+		{Name: "IOE_GPP_B", Size: 24, GpioBase: common.GpioBaseNoMap},
+	}},
+	{Die: "ioe", Prefix: "IOE_", Base: 0x700, Stride: 16, HostSwOwn: 0xb0,
+		ResetMap: rstMapGpp, PinBase: -1, Groups: []common.Group{
+		{Name: "IOE_GPP_C", Size: 24, GpioBase: common.GpioBaseNoMap},
+		{Name: "IOE_GPP_D", Size: 24, GpioBase: common.GpioBaseNoMap},
+	}},
+}
+
+// PlatformSpecific - the pad macros are generated by the common GPIO block, see
+// platforms/block/macro.go
+type PlatformSpecific struct {}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	return communities
+}
+
+// GroupNameExtract - This function extracts the group ID, if it exists in a row
+// line      : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func (platform PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	return common.GroupNameExtract(platform.CommunitiesGet(nil), line)
+}
+
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// See src/soc/intel/meteorlake/include/soc/gpe.h, only the SoC groups can
+	// be routed to GPE0
+	var groups = map[uint8]string{
+		0x0: "GPP_V",
+		0x1: "GPP_C",
+		0x2: "GPP_A",
+		0x3: "GPP_E",
+		0x4: "GPP_H",
+		0x5: "GPP_F",
+		0x6: "GPP_S",
+		0x7: "GPP_B",
+		0x8: "GPP_D",
+		0x9: "GPD",
+	}
+	group, valid := groups[value]
+	return valid, group
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// KeywordCheck - This function is used to filter parsed lines of the configuration file and
+//                returns true if the keyword is contained in the line.
+// line      : string from the configuration file
+func (platform PlatformSpecific) KeywordCheck(line string) bool {
+	return common.KeywordCheck(platform.CommunitiesGet(nil), line)
+}
@@ -121,6 +121,11 @@ func sciRoute() bool {
 	if dw0.GetGPIOInputRouteSCI() == 0 {
 		return false
 	}
+	if macro.IsDebounceEnabled() {
+		// e.g. PAD_CFG_GPI_SCI_DEBOUNCE(GPP_B18, NONE, DEEP, EDGE_SINGLE, INVERT, DEBOUNCE_16_RTC),
+		macro.Add("_SCI_DEBOUNCE").Add("(").Id().Pull().Rstsrc().Trig().Invert().Debounce().Add("),")
+		return true
+	}
 	// e.g. PAD_CFG_GPI_SCI(GPP_B18, UP_20K, PLTRST, LEVEL, INVERT),
 	if (dw0.GetRXLevelEdgeConfiguration() & common.TRIG_EDGE_SINGLE) != 0 {
 		// e.g. PAD_CFG_GPI_ACPI_SCI(GPP_G2, NONE, DEEP, YES),
@@ -156,6 +161,38 @@ func smiRoute() bool {
 	return true
 }
 
+// Generate macro for GPIO input owned by the GPIO driver, if the GPI_IE register
+// from the dump shows whether the driver has enabled the interrupt
+func driverInterrupt() bool {
+	macro := common.GetMacro()
+	dw0 := macro.Register(PAD_CFG_DW0)
+	if !macro.IsOwnershipDriver() {
+		return false
+	}
+	switch macro.PadInterruptGet() {
+	case common.PAD_INT_ENABLE:
+		if dw0.GetRXLevelEdgeConfiguration() == common.TRIG_OFF {
+			return false
+		}
+		if macro.IsDebounceEnabled() {
+			// e.g. PAD_CFG_GPI_INT_DEBOUNCE(GPP_B4, NONE, PLTRST, EDGE_SINGLE, DEBOUNCE_8_RTC),
+			macro.Add("_INT_DEBOUNCE").Add("(").Id().Pull().Rstsrc().Trig().Debounce().Add("),")
+			return true
+		}
+		// e.g. PAD_CFG_GPI_INT(GPP_B4, NONE, PLTRST, EDGE_SINGLE),
+		macro.Add("_INT").Add("(").Id().Pull().Rstsrc().Trig().Add("),")
+		return true
+	case common.PAD_INT_DISABLE:
+		if dw0.GetRXLevelEdgeConfiguration() != common.TRIG_OFF {
+			return false
+		}
+		// e.g. PAD_CFG_GPI_GPIO_DRIVER(GPP_B4, NONE, PLTRST),
+		macro.Add("_GPIO_DRIVER").Add("(").Id().Pull().Rstsrc().Add("),")
+		return true
+	}
+	return false
+}
+
 // Adds PAD_CFG_GPI macro with arguments
 func (PlatformSpecific) GpiMacroAdd() {
 	macro := common.GetMacro()
@@ -174,6 +211,9 @@ func (PlatformSpecific) GpiMacroAdd() {
 
 	switch argc := len(ids); argc {
 	case 0:
+		if driverInterrupt() {
+			break
+		}
 		// e.g. PAD_CFG_GPI_TRIG_OWN(pad, pull, rst, trig, own)
 		macro.Add("_TRIG_OWN").Add("(").Id().Pull().Rstsrc().Trig().Own().Add("),")
 	case 1:
@@ -262,17 +302,17 @@ func (PlatformSpecific) NoConnMacroAdd() {
 }
 
 // GenMacro - generate pad macro
-// dw0 : DW0 config register value
-// dw1 : DW1 config register value
+// dw : values of the pad configuration registers
+// lock : pad configuration lock state
+// interrupt : driver-mode interrupt state
+// level : info level of the comments in the macro
 // return: string of macro
 //         error
-func (PlatformSpecific) GenMacro(id string, dw0 uint32, dw1 uint32, ownership uint8) string {
+func (PlatformSpecific) GenMacro(id string, dw [MAX_DW_NUM]uint32, ownership uint8, lock uint8, interrupt uint8, level uint8) string {
 	macro := common.GetInstanceMacro(PlatformSpecific{}, fields.InterfaceGet())
 	macro.Clear()
-	macro.Register(PAD_CFG_DW0).CntrMaskFieldsClear(common.AllFields)
-	macro.Register(PAD_CFG_DW0).CntrMaskFieldsClear(common.AllFields)
-	macro.PadIdSet(id).SetPadOwnership(ownership)
-	macro.Register(PAD_CFG_DW0).ValueSet(dw0).ReadOnlyFieldsSet(PAD_CFG_DW0_RO_FIELDS)
-	macro.Register(PAD_CFG_DW1).ValueSet(dw1).ReadOnlyFieldsSet(PAD_CFG_DW1_RO_FIELDS)
+	macro.PadIdSet(id).SetPadOwnership(ownership).SetPadLock(lock).SetPadInterrupt(interrupt).
+			SetInfoLevel(level)
+	macro.RegistersSet(dw, []uint32{PAD_CFG_DW0_RO_FIELDS, PAD_CFG_DW1_RO_FIELDS})
 	return macro.Generate()
 }
@@ -0,0 +1,105 @@
+package snr
+
+// pinmux - native functions of the pads, the first name is NF1. Groups GPP_D and
+// higher differ between the Sunrise Point LP and H and are not described.
+// See Intel 100 Series Chipset Family PCH Datasheet, GPIO Multiplexing
+var pinmux = map[string][]string{
+	"GPP_A0":  {"RCIN#"},
+	"GPP_A1":  {"LAD0", "ESPI_IO0"},
+	"GPP_A2":  {"LAD1", "ESPI_IO1"},
+	"GPP_A3":  {"LAD2", "ESPI_IO2"},
+	"GPP_A4":  {"LAD3", "ESPI_IO3"},
+	"GPP_A5":  {"LFRAME#", "ESPI_CS#"},
+	"GPP_A6":  {"SERIRQ"},
+	"GPP_A7":  {"PIRQA#"},
+	"GPP_A8":  {"CLKRUN#"},
+	"GPP_A9":  {"CLKOUT_LPC0", "ESPI_CLK"},
+	"GPP_A10": {"CLKOUT_LPC1"},
+	"GPP_A11": {"PME#"},
+	"GPP_A12": {"BM_BUSY#", "ISH_GP6", "SX_EXIT_HOLDOFF#"},
+	"GPP_A13": {"SUSWARN#/SUSPWRDNACK"},
+	"GPP_A14": {"SUS_STAT#", "ESPI_RESET#"},
+	"GPP_A15": {"SUS_ACK#"},
+	"GPP_A18": {"ISH_GP0"},
+	"GPP_A19": {"ISH_GP1"},
+	"GPP_A20": {"ISH_GP2"},
+	"GPP_A21": {"ISH_GP3"},
+	"GPP_A22": {"ISH_GP4"},
+	"GPP_A23": {"ISH_GP5"},
+
+	"GPP_B0":  {"CORE_VID0"},
+	"GPP_B1":  {"CORE_VID1"},
+	"GPP_B2":  {"VRALERT#"},
+	"GPP_B3":  {"CPU_GP2"},
+	"GPP_B4":  {"CPU_GP3"},
+	"GPP_B5":  {"SRCCLKREQ0#"},
+	"GPP_B6":  {"SRCCLKREQ1#"},
+	"GPP_B7":  {"SRCCLKREQ2#"},
+	"GPP_B8":  {"SRCCLKREQ3#"},
+	"GPP_B9":  {"SRCCLKREQ4#"},
+	"GPP_B10": {"SRCCLKREQ5#"},
+	"GPP_B11": {"EXT_PWR_GATE#"},
+	"GPP_B12": {"SLP_S0#"},
+	"GPP_B13": {"PLTRST#"},
+	"GPP_B14": {"SPKR"},
+	"GPP_B15": {"GSPI0_CS#"},
+	"GPP_B16": {"GSPI0_CLK"},
+	"GPP_B17": {"GSPI0_MISO"},
+	"GPP_B18": {"GSPI0_MOSI"},
+	"GPP_B19": {"GSPI1_CS#"},
+	"GPP_B20": {"GSPI1_CLK"},
+	"GPP_B21": {"GSPI1_MISO"},
+	"GPP_B22": {"GSPI1_MOSI"},
+	"GPP_B23": {"SML1ALERT#", "PCHHOT#"},
+
+	"GPP_C0":  {"SMBCLK"},
+	"GPP_C1":  {"SMBDATA"},
+	"GPP_C2":  {"SMBALERT#"},
+	"GPP_C3":  {"SML0CLK"},
+	"GPP_C4":  {"SML0DATA"},
+	"GPP_C5":  {"SML0ALERT#"},
+	"GPP_C6":  {"SML1CLK"},
+	"GPP_C7":  {"SML1DATA"},
+	"GPP_C8":  {"UART0_RXD"},
+	"GPP_C9":  {"UART0_TXD"},
+	"GPP_C10": {"UART0_RTS#"},
+	"GPP_C11": {"UART0_CTS#"},
+	"GPP_C12": {"UART1_RXD", "ISH_UART1_RXD"},
+	"GPP_C13": {"UART1_TXD", "ISH_UART1_TXD"},
+	"GPP_C14": {"UART1_RTS#", "ISH_UART1_RTS#"},
+	"GPP_C15": {"UART1_CTS#", "ISH_UART1_CTS#"},
+	"GPP_C16": {"I2C0_SDA"},
+	"GPP_C17": {"I2C0_SCL"},
+	"GPP_C18": {"I2C1_SDA"},
+	"GPP_C19": {"I2C1_SCL"},
+	"GPP_C20": {"UART2_RXD"},
+	"GPP_C21": {"UART2_TXD"},
+	"GPP_C22": {"UART2_RTS#"},
+	"GPP_C23": {"UART2_CTS#"},
+
+	"GPD0":  {"BATLOW#"},
+	"GPD1":  {"ACPRESENT"},
+	"GPD2":  {"LAN_WAKE#"},
+	"GPD3":  {"PWRBTN#"},
+	"GPD4":  {"SLP_S3#"},
+	"GPD5":  {"SLP_S4#"},
+	"GPD6":  {"SLP_A#"},
+	"GPD8":  {"SUSCLK"},
+	"GPD9":  {"SLP_WLAN#"},
+	"GPD10": {"SLP_S5#"},
+	"GPD11": {"LANPHYPC"},
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	functions, valid := pinmux[id]
+	if !valid || mode == 0 || int(mode) > len(functions) {
+		return false, ""
+	}
+	return true, functions[mode-1]
+}
@@ -2,12 +2,20 @@ package snr
 
 import "strings"
 
+// Local packages
+import "../common"
+import "../../config"
+
 // GroupNameExtract - This function extracts the group ID, if it exists in a row
 // line      : string from the configuration file
 // return
 //     bool   : true if the string contains a group identifier
 //     string : group identifier
-func (PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+func (platform PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	if config.IsPlatformSunrise() && config.IsSkuSet() {
+		// only the groups of the selected PCH SKU
+		return common.GroupNameExtract(platform.CommunitiesGet(nil), line)
+	}
 	for _, groupKeyword := range []string{
 		"GPP_A", "GPP_B", "GPP_F",
 		"GPP_C", "GPP_D", "GPP_E",
@@ -22,6 +30,93 @@ func (PlatformSpecific) GroupNameExtract(line string) (bool, string) {
 	return false, ""
 }
 
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// See src/soc/intel/skylake/include/soc/gpe.h
+	var groups = map[uint8]string{
+		0x0: "GPP_A",
+		0x1: "GPP_B",
+		0x2: "GPP_C",
+		0x3: "GPP_D",
+		0x4: "GPP_E",
+		0x5: "GPP_F",
+		0x6: "GPP_G",
+		0x7: "GPP_H",
+		0x8: "GPP_I",
+		0xa: "GPD",
+	}
+	group, valid := groups[value]
+	return valid, group
+}
+
+// See src/soc/intel/skylake/include/soc/gpio_soc_defs.h and
+// drivers/pinctrl/intel/pinctrl-sunrisepoint.c in Linux, the GPD pads are not
+// exposed by the Linux driver
+var communitiesLp = []common.Community{
+	{Base: 0x400, Stride: 8, PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_A", Size: 24}, {Name: "GPP_B", Size: 24},
+	}},
+	{Base: 0x400, Stride: 8, PinBase: 48, Groups: []common.Group{
+		{Name: "GPP_C", Size: 24}, {Name: "GPP_D", Size: 24}, {Name: "GPP_E", Size: 24},
+	}},
+	{Base: 0x400, Stride: 8, PinBase: -1, Groups: []common.Group{{Name: "GPD", Size: 12}}},
+	{Base: 0x400, Stride: 8, PinBase: 120, Groups: []common.Group{
+		{Name: "GPP_F", Size: 24}, {Name: "GPP_G", Size: 8},
+	}},
+}
+
+var communitiesH = []common.Community{
+	{Base: 0x400, Stride: 8, PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_A", Size: 24}, {Name: "GPP_B", Size: 24},
+	}},
+	{Base: 0x400, Stride: 8, PinBase: 48, Groups: []common.Group{
+		{Name: "GPP_C", Size: 24}, {Name: "GPP_D", Size: 24}, {Name: "GPP_E", Size: 13},
+		{Name: "GPP_F", Size: 24}, {Name: "GPP_G", Size: 24}, {Name: "GPP_H", Size: 24},
+	}},
+	{Base: 0x400, Stride: 8, PinBase: -1, Groups: []common.Group{{Name: "GPD", Size: 12}}},
+	{Base: 0x400, Stride: 8, PinBase: 181, Groups: []common.Group{{Name: "GPP_I", Size: 11}}},
+}
+
+// SkuDetect - returns the PCH SKU with the pad groups from the dump
+// groups : pad groups from the configuration file, GPP_H and GPP_I are only
+//          present in the PCH-H
+func SkuDetect(groups []string) string {
+	for _, group := range groups {
+		if group == "GPP_H" || group == "GPP_I" {
+			return "h"
+		}
+	}
+	return "lp"
+}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file. The groups are not used if
+//          the SKU is set.
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	if config.IsPlatformSunrise() && config.IsSkuSet() {
+		// Union Point (KBP-H) does not need its own descriptors: its GPIO
+		// controller is register compatible with SPT-H and has the same groups
+		// GPP_A to GPP_I with the same sizes. coreboot uses the Skylake
+		// gpio_soc_defs.h of PCH-H for it, and the Linux driver matches
+		// INT345D with spth_soc_data.
+		var communities = map[string][]common.Community{
+			"lp"  : communitiesLp,
+			"h"   : communitiesH,
+			"kbp" : communitiesH,
+		}
+		return communities[config.SkuGet()]
+	}
+	if SkuDetect(groups) == "h" {
+		return communitiesH
+	}
+	return communitiesLp
+}
+
 // KeywordCheck - This function is used to filter parsed lines of the configuration file and
 //                returns true if the keyword is contained in the line.
 // line      : string from the configuration file
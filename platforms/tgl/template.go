@@ -0,0 +1,181 @@
+package tgl
+
+// Local packages
+import "../common"
+import "../../config"
+
+// Reset mapping of the GPP communities, the mapping of GPD is the same as the
+// logical one
+var rstMapGpp = []uint8{common.RST_RSMRST, common.RST_DEEP, common.RST_PLTRST}
+
+var hvcmos = []string{"L_BKLTEN", "L_BKLTCTL", "L_VDDEN", "SYS_PWROK", "SYS_RESETB", "MLK_RSTB"}
+
+var jtag = []string{
+	"JTAG_TDO", "JTAGX", "PRDYB", "PREQB", "CPU_TRSTB", "JTAG_TDI", "JTAG_TMS", "JTAG_TCK",
+	"DBG_PMODE",
+}
+
+var spi = []string{
+	"SPI0_IO_2", "SPI0_IO_3", "SPI0_MOSI_IO_0", "SPI0_MISO_IO_1",
+	"SPI0_FLASH_0_CSB", "SPI0_FLASH_1_CSB", "SPI0_FLASH_2_CSB", "SPI0_CLK", "SPI0_CLK_LOOPBK",
+}
+
+// See src/soc/intel/tigerlake/include/soc/gpio_soc_defs.h and
+// drivers/pinctrl/intel/pinctrl-tigerlake.c in Linux. The Community 3 (CPU pads)
+// and GPD are not exposed by the Linux driver.
+var communitiesLp = []common.Community{
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_B", Size: 24, GpioBase: 0,
+				Pads: []string{"GSPI0_CLK_LOOPBK", "GSPI1_CLK_LOOPBK"}},
+		{Name: "GPP_T", Size: 16, GpioBase: 32},
+		{Name: "GPP_A", Size: 24, GpioBase: 64, Pads: []string{"ESPI_CLK_LOOPBK"}},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 67, Groups: []common.Group{
+		{Name: "GPP_S", Size: 8, GpioBase: 96},
+		{Name: "GPP_H", Size: 24, GpioBase: 128},
+		{Name: "GPP_D", Size: 20, GpioBase: 160, Pads: []string{"GSPI2_CLK_LOOPBK"}},
+		{Name: "GPP_U", Size: 24, GpioBase: 192,
+				Pads: []string{"GSPI3_CLK_LOOPBK", "GSPI4_CLK_LOOPBK"}},
+		{Name: "GPP_VGPIO_", Size: 34, GpioBase: 224, Virtual: true},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, PinBase: -1, Groups: []common.Group{
+		{Name: "GPD", Size: 12},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp, PinBase: -1},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 180, Groups: []common.Group{
+		{Name: "GPP_C", Size: 24, GpioBase: 256},
+		{Name: "GPP_F", Size: 24, GpioBase: 288, Pads: []string{"GPPF_CLK_LOOPBK"}},
+		{Name: "HVCMOS", GpioBase: common.GpioBaseNoMap, Pads: hvcmos},
+		{Name: "GPP_E", Size: 24, GpioBase: 320, Pads: []string{"GPPE_CLK_LOOPBK"}},
+		{Name: "JTAG", GpioBase: common.GpioBaseNoMap, Pads: jtag},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 269, Groups: []common.Group{
+		{Name: "GPP_R", Size: 8, GpioBase: 352},
+		{Name: "SPI", GpioBase: common.GpioBaseNoMap, Pads: spi},
+	}},
+}
+
+var communitiesH = []common.Community{
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 0, Groups: []common.Group{
+		{Name: "GPP_A", Size: 25, GpioBase: 0},
+		{Name: "GPP_R", Size: 20, GpioBase: 32},
+		{Name: "GPP_B", Size: 24, GpioBase: 64,
+				Pads: []string{"GSPI0_CLK_LOOPBK", "GSPI1_CLK_LOOPBK"}},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 71, Groups: []common.Group{
+		{Name: "GPP_D", Size: 24, GpioBase: 96, Pads: []string{"GSPI2_CLK_LOOPBK"}},
+		{Name: "GPP_C", Size: 24, GpioBase: 128},
+		{Name: "GPP_S", Size: 8, GpioBase: 160},
+		{Name: "GPP_G", Size: 16, GpioBase: 192},
+		{Name: "GPP_VGPIO_", Size: 28, GpioBase: 224, Virtual: true},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, PinBase: -1, Groups: []common.Group{
+		{Name: "GPD", Size: 12},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 172, Groups: []common.Group{
+		{Name: "GPP_E", Size: 13, GpioBase: 256},
+		{Name: "GPP_F", Size: 24, GpioBase: 288},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 209, Groups: []common.Group{
+		{Name: "GPP_H", Size: 24, GpioBase: 320},
+		{Name: "GPP_J", Size: 10, GpioBase: 352},
+		{Name: "GPP_K", Size: 12, GpioBase: 384},
+	}},
+	{Base: 0x700, Stride: 16, HostSwOwn: 0xb0, ResetMap: rstMapGpp,
+		PinBase: 255, Groups: []common.Group{
+		{Name: "GPP_I", Size: 15, GpioBase: 416},
+		{Name: "JTAG", GpioBase: common.GpioBaseNoMap, Pads: jtag},
+	}},
+}
+
+// PlatformSpecific - the pad macros are generated by the common GPIO block, see
+// platforms/block/macro.go
+type PlatformSpecific struct {}
+
+// CommunitiesGet - returns the descriptors of the GPIO communities
+// groups : pad groups from the configuration file
+func (PlatformSpecific) CommunitiesGet(groups []string) []common.Community {
+	if config.IsSku("h") {
+		return communitiesH
+	}
+	return communitiesLp
+}
+
+// GroupNameExtract - This function extracts the group ID, if it exists in a row
+// line      : string from the configuration file
+// return
+//     bool   : true if the string contains a group identifier
+//     string : group identifier
+func (platform PlatformSpecific) GroupNameExtract(line string) (bool, string) {
+	return common.GroupNameExtract(platform.CommunitiesGet(nil), line)
+}
+
+// GpeGroupNameGet - returns the group identifier that is selected by the value of the
+//                   GPE0_DWx field in the MISCCFG register
+// value : GPE0_DWx field value
+// return
+//     bool   : true if the value corresponds to the pad group
+//     string : group identifier
+func (PlatformSpecific) GpeGroupNameGet(value uint8) (bool, string) {
+	// See src/soc/intel/tigerlake/include/soc/gpe.h
+	var groups = map[uint8]string{
+		0x0: "GPP_B",
+		0x1: "GPP_T",
+		0x2: "GPP_A",
+		0x3: "GPP_R",
+		0x4: "GPD",
+		0x5: "GPP_S",
+		0x6: "GPP_H",
+		0x7: "GPP_D",
+		0x8: "GPP_U",
+		0xa: "GPP_F",
+		0xb: "GPP_C",
+		0xc: "GPP_E",
+	}
+	if config.IsSku("h") {
+		groups = map[uint8]string{
+			0x0: "GPP_A",
+			0x1: "GPP_R",
+			0x2: "GPP_B",
+			0x3: "GPP_D",
+			0x4: "GPP_C",
+			0x5: "GPP_S",
+			0x6: "GPP_G",
+			0x7: "GPD",
+			0x8: "GPP_E",
+			0x9: "GPP_F",
+			0xa: "GPP_H",
+			0xb: "GPP_J",
+			0xc: "GPP_K",
+			0xd: "GPP_I",
+		}
+	}
+	group, valid := groups[value]
+	return valid, group
+}
+
+// NativeFunctionGet - returns the name of the native function of the pad
+// id   : pad ID string
+// mode : pad mode (PMODE), 1 corresponds to NF1
+// return
+//     bool   : true if the function is described in the pin-mux table
+//     string : function name
+func (PlatformSpecific) NativeFunctionGet(id string, mode uint8) (bool, string) {
+	// Not supported
+	return false, ""
+}
+
+// KeywordCheck - This function is used to filter parsed lines of the configuration file and
+//                returns true if the keyword is contained in the line.
+// line      : string from the configuration file
+func (platform PlatformSpecific) KeywordCheck(line string) bool {
+	return common.KeywordCheck(platform.CommunitiesGet(nil), line)
+}
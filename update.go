@@ -0,0 +1,127 @@
+package main
+
+import "fmt"
+import "io/ioutil"
+import "os"
+import "path/filepath"
+import "strings"
+
+import "./parser"
+
+const diffContext = 3
+
+// unifiedDiff - generates a unified diff for files with the same number of lines,
+// where lines can only be replaced
+// name : file name
+// old  : original lines
+// new  : updated lines
+// return: diff string
+func unifiedDiff(name string, old []string, new []string) string {
+	var changed []int
+	for i := range old {
+		if old[i] != new[i] {
+			changed = append(changed, i)
+		}
+	}
+	if len(changed) == 0 {
+		return ""
+	}
+
+	var diff strings.Builder
+	fmt.Fprintf(&diff, "--- a/%s\n+++ b/%s\n", name, name)
+	for i := 0; i < len(changed); {
+		// merge the changes whose context overlaps into one hunk
+		j := i
+		for j+1 < len(changed) && changed[j+1]-changed[j] <= 2*diffContext {
+			j++
+		}
+		start := changed[i] - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := changed[j] + diffContext + 1
+		if end > len(old) {
+			end = len(old)
+		}
+		fmt.Fprintf(&diff, "@@ -%d,%d +%d,%d @@\n", start+1, end-start, start+1, end-start)
+		for k := start; k < end; k++ {
+			if old[k] == new[k] {
+				fmt.Fprintf(&diff, " %s\n", old[k])
+			} else {
+				fmt.Fprintf(&diff, "-%s\n", old[k])
+			}
+			// print the added lines after the block of removed lines
+			if old[k] != new[k] && (k+1 == end || old[k+1] == new[k+1]) {
+				first := k
+				for first > start && old[first-1] != new[first-1] {
+					first--
+				}
+				for l := first; l <= k; l++ {
+					fmt.Fprintf(&diff, "+%s\n", new[l])
+				}
+			}
+		}
+		i = j + 1
+	}
+	return diff.String()
+}
+
+// diffPathGet - returns the path of the file for the diff header relative to the
+// root of its git repository, so the diff can be applied with git apply. Outside
+// a repository, the file name is used and the diff is applied with patch in the
+// directory of the file.
+// name : path to the file
+// return: path for the diff header
+func diffPathGet(name string) string {
+	path, err := filepath.Abs(name)
+	if err != nil {
+		return filepath.Base(name)
+	}
+	for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			if rel, err := filepath.Rel(dir, path); err == nil {
+				return filepath.ToSlash(rel)
+			}
+			break
+		}
+		if dir == filepath.Dir(dir) {
+			break
+		}
+	}
+	return filepath.Base(name)
+}
+
+// updateGpioFile - updates the pad configuration macros in the existing coreboot
+// gpio.c according to the dump
+// parser : parser data structure of the dump
+// name   : path to gpio.c
+// write  : apply the changes to the file instead of generating the diff
+// output : path to the file with the diff, the diagnostics of the parser are
+//          printed to stdout, so the diff is written separately
+func updateGpioFile(parser *parser.ParserData, name string, write bool, output string) error {
+	content, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(content), "\n")
+	updated, count := parser.UpdateLines(lines)
+	fmt.Printf("%d macros were updated\n", count)
+	if count == 0 {
+		return nil
+	}
+	if write {
+		info, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(name, []byte(strings.Join(updated, "\n")), info.Mode())
+	}
+	if err := os.MkdirAll(filepath.Dir(output), os.ModePerm); err != nil {
+		return err
+	}
+	if n := len(lines); n > 1 && lines[n-1] == "" {
+		// the empty string after the newline at the end of the file is not a line
+		lines, updated = lines[:n-1], updated[:n-1]
+	}
+	return ioutil.WriteFile(output, []byte(unifiedDiff(diffPathGet(name), lines, updated)), 0644)
+}
@@ -0,0 +1,239 @@
+package main
+
+import "fmt"
+import "os"
+import "path/filepath"
+
+import "./parser"
+import "./config"
+
+// createLayoutFile - creates a file of the variant layout and all the
+// directories on its path
+// dir  : root directory of the layout
+// name : file path relative to the root directory
+func createLayoutFile(dir string, name string) (*os.File, error) {
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	fmt.Println("Output generated file:", path)
+	return os.Create(path)
+}
+
+// generateBaseboardGpioC - generates variants/baseboard/gpio.c with the base,
+// early, override and lock pad configuration tables and their accessor functions.
+// The prototypes of the accessors are in variants.h, see generateBaseboardVariantsH()
+// parser : parser data structure
+// early  : list of pads for the early pad configuration table
+func generateBaseboardGpioC(parser *parser.ParserData, early []string) error {
+	config.OutputGenFile.WriteString(`/* SPDX-License-Identifier: GPL-2.0-only */
+
+#include <baseboard/gpio.h>
+#include <baseboard/variants.h>
+#include <commonlib/helpers.h>
+#include <types.h>
+
+/* Pad configuration was generated automatically using intelp2m utility */
+static const struct pad_config gpio_table[] = {
+`)
+	parser.PadMapFprint()
+	config.OutputGenFile.WriteString("};\n")
+	lock := parser.LockMapFprint()
+	if config.IsAssertFlagUsed() {
+		parser.AssertMapFprint()
+	}
+
+	if len(early) != 0 {
+		config.OutputGenFile.WriteString(`
+/* Early pad configuration in bootblock */
+static const struct pad_config early_gpio_table[] = {
+`)
+		for _, id := range parser.PadListFprint(early) {
+			fmt.Printf("Warning: early pad %s was not found in the dump!\n", id)
+		}
+		config.OutputGenFile.WriteString("};\n")
+	}
+
+	config.OutputGenFile.WriteString(`
+const struct pad_config *__weak variant_base_gpio_table(size_t *num)
+{
+	*num = ARRAY_SIZE(gpio_table);
+	return gpio_table;
+}
+`)
+	if len(early) != 0 {
+		config.OutputGenFile.WriteString(`
+const struct pad_config *__weak variant_early_gpio_table(size_t *num)
+{
+	*num = ARRAY_SIZE(early_gpio_table);
+	return early_gpio_table;
+}
+`)
+	} else {
+		config.OutputGenFile.WriteString(`
+const struct pad_config *__weak variant_early_gpio_table(size_t *num)
+{
+	*num = 0;
+	return NULL;
+}
+`)
+	}
+	config.OutputGenFile.WriteString(`
+const struct pad_config *__weak variant_override_gpio_table(size_t *num)
+{
+	*num = 0;
+	return NULL;
+}
+`)
+	if lock {
+		config.OutputGenFile.WriteString(`
+const struct gpio_lock_config *__weak variant_lock_gpio_table(size_t *num)
+{
+	*num = ARRAY_SIZE(pad_lock_config);
+	return pad_lock_config;
+}
+`)
+	} else {
+		config.OutputGenFile.WriteString(`
+const struct gpio_lock_config *__weak variant_lock_gpio_table(size_t *num)
+{
+	*num = 0;
+	return NULL;
+}
+`)
+	}
+	return nil
+}
+
+// generateBaseboardGpioH - generates include/baseboard/gpio.h with the GPE0
+// routing and the wake sources from the dump
+// parser : parser data structure
+func generateBaseboardGpioH(parser *parser.ParserData) error {
+	config.OutputGenFile.WriteString(`/* SPDX-License-Identifier: GPL-2.0-only */
+
+#ifndef __BASEBOARD_GPIO_H__
+#define __BASEBOARD_GPIO_H__
+
+#include <soc/gpe.h>
+#include <soc/gpio.h>
+`)
+	parser.GpeFprint()
+	parser.InterruptFprint()
+	parser.IrqMapFprint()
+	_, err := config.OutputGenFile.WriteString(`
+#endif /* __BASEBOARD_GPIO_H__ */
+`)
+	return err
+}
+
+// generateBaseboardVariantsH - generates include/baseboard/variants.h
+func generateBaseboardVariantsH() error {
+	_, err := config.OutputGenFile.WriteString(`/* SPDX-License-Identifier: GPL-2.0-only */
+
+#ifndef __BASEBOARD_VARIANTS_H__
+#define __BASEBOARD_VARIANTS_H__
+
+#include <soc/gpio.h>
+#include <stddef.h>
+
+/*
+ * The next set of functions return the gpio table and fill in the number of
+ * entries for each table.
+ */
+const struct pad_config *variant_base_gpio_table(size_t *num);
+const struct pad_config *variant_early_gpio_table(size_t *num);
+const struct pad_config *variant_override_gpio_table(size_t *num);
+
+/*
+ * Returns the pads to lock with gpio_lock_pads() from the SMM finalize handler
+ * and fills in the number of entries.
+ */
+const struct gpio_lock_config *variant_lock_gpio_table(size_t *num);
+
+#endif /* __BASEBOARD_VARIANTS_H__ */
+`)
+	return err
+}
+
+// generateVariantLayout - generates the coreboot baseboard/variant source layout:
+//     variants/baseboard/gpio.c
+//     variants/baseboard/include/baseboard/gpio.h
+//     variants/baseboard/include/baseboard/variants.h
+// parser : parser data structure
+// dir    : target directory
+// early  : list of pads for the early pad configuration table
+func generateVariantLayout(parser *parser.ParserData, dir string, early []string) error {
+	for _, layout := range []struct {
+		name     string
+		generate func() error
+	}{
+		{"variants/baseboard/gpio.c", func() error {
+			return generateBaseboardGpioC(parser, early)
+		}},
+		{"variants/baseboard/include/baseboard/gpio.h", func() error {
+			return generateBaseboardGpioH(parser)
+		}},
+		{"variants/baseboard/include/baseboard/variants.h", generateBaseboardVariantsH},
+	} {
+		file, err := createLayoutFile(dir, layout.name)
+		if err != nil {
+			return err
+		}
+		config.OutputGenFile = file
+		err = layout.generate()
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generateVariantOverride - generates the variant gpio.c with the pads that
+// differ from the baseboard pad configuration
+// variant  : parser data structure of the variant dump
+// basefile : path to the baseboard gpio.c
+// output   : path to the generated file
+func generateVariantOverride(variant *parser.ParserData, basefile string, output string) error {
+	file, err := os.Open(basefile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Baseboard gpio.c uses coreboot macros, so parse it with the gpio.h template
+	template, input := config.TemplateGet(), config.InputRegDumpFile
+	config.TemplateSet(config.TempGpioh)
+	config.InputRegDumpFile = file
+	base := parser.ParserData{}
+	base.Parse()
+	config.TemplateSet(template)
+	config.InputRegDumpFile = input
+
+	outputfile, err := createLayoutFile(filepath.Dir(output), filepath.Base(output))
+	if err != nil {
+		return err
+	}
+	defer outputfile.Close()
+	config.OutputGenFile = outputfile
+
+	config.OutputGenFile.WriteString(`/* SPDX-License-Identifier: GPL-2.0-only */
+
+#include <baseboard/gpio.h>
+#include <baseboard/variants.h>
+#include <commonlib/helpers.h>
+
+/* Pad configuration was generated automatically using intelp2m utility */
+static const struct pad_config override_gpio_table[] = {`)
+	overrides := variant.OverrideMapFprint(&base)
+	config.OutputGenFile.WriteString(`};
+
+const struct pad_config *variant_override_gpio_table(size_t *num)
+{
+	*num = ARRAY_SIZE(override_gpio_table);
+	return override_gpio_table;
+}
+`)
+	fmt.Printf("%d pads differ from the baseboard\n", overrides)
+	return nil
+}